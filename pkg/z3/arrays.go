@@ -0,0 +1,197 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// Create an array type.
+//
+// We usually represent the array type as: [domain -> rangeSort].
+// Arrays are usually used to model the heap/memory in software verification.
+func (context *Context) ArraySort(domain, rangeSort *Sort) *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_array_sort(context.z3Context, domain.z3Sort, rangeSort.z3Sort),
+		)
+	}, domain, rangeSort)
+}
+
+// Create an array type with N arguments.
+//
+// We usually represent the array type as: [domains[0], ..., domains[N-1] -> rangeSort].
+// Panics with an *Error if domains is empty.
+func (context *Context) ArraySortN(domains []*Sort, rangeSort *Sort) *Sort {
+	if len(domains) == 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "array sorts need at least one domain"})
+	}
+
+	return compute(context, func() *Sort {
+		domain := make([]C.Z3_sort, len(domains))
+		for idx := range domains {
+			domain[idx] = domains[idx].z3Sort
+		}
+
+		return context.wrapSort(
+			C.Z3_mk_array_sort_n(
+				context.z3Context,
+				C.uint(len(domain)), pointerTo(domain),
+				rangeSort.z3Sort,
+			),
+		)
+	}, domains, rangeSort)
+}
+
+// Return the domain of the given array sort.
+// In the case of a multi-dimensional array, this function returns the sort of the first dimension.
+func (sort *Sort) ArrayDomain() *Sort {
+	return compute(sort.context, func() *Sort {
+		return sort.context.wrapSort(
+			C.Z3_get_array_sort_domain(sort.context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Return the range of the given array sort.
+func (sort *Sort) ArrayRange() *Sort {
+	return compute(sort.context, func() *Sort {
+		return sort.context.wrapSort(
+			C.Z3_get_array_sort_range(sort.context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Array read.
+// The argument array is the array and index is the index of the array that gets read.
+//
+// The node array must have an array sort [domain -> range],
+// and index must have the sort domain.
+// The sort of the result is range.
+func Select(array, index *AST) *AST {
+	return binary(
+		func(context C.Z3_context, array, index C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_select(context, array, index)
+		}, array, index,
+	)
+}
+
+// N-ary array read.
+// The argument array is the array and indices are the indices of the array that gets read.
+// Panics with an *Error if indices is empty.
+func SelectN(array *AST, indices ...*AST) *AST {
+	if len(indices) == 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "array reads need at least one index"})
+	}
+
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_select_n(context, operands[0], length-1, pointerTo(operands[1:]))
+		}, array, indices...,
+	)
+}
+
+// Array update.
+//
+// The node array must have an array sort [domain -> range], index must have sort domain,
+// value must have sort range. The sort of the result is [domain -> range].
+// The semantics of this function is given by the theory of arrays described in the SMT-LIB
+// standard. See http://smtlib.org for more details.
+// The result of this function is an array that is equal to array (with respect to select)
+// on all indices except for index, where it maps to value (and the select of array
+// with respect to index may be a different value).
+func Store(array, index, value *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, array, index, value C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_store(context, array, index, value)
+		}, array, index, value,
+	)
+}
+
+// N-ary array update.
+// Panics with an *Error if indices is empty.
+func StoreN(array *AST, indices []*AST, value *AST) *AST {
+	if len(indices) == 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "array updates need at least one index"})
+	}
+
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_store_n(context, operands[0], length-2, pointerTo(operands[1:length-1]), operands[length-1])
+		}, array, append(indices[:len(indices):len(indices)], value)...,
+	)
+}
+
+// Create the constant array.
+//
+// The resulting term is an array, such that a select on an arbitrary index
+// produces the value value.
+func K(domain *Sort, value *AST) *AST {
+	return compute(value.context, func() *AST {
+		return value.context.wrapAST(
+			C.Z3_mk_const_array(value.context.z3Context, domain.z3Sort, value.z3AST),
+		)
+	}, domain, value)
+}
+
+// Map function on the argument arrays.
+//
+// The n nodes arrays must be of array sorts [domain_i -> range_i].
+// The function declaration function must have type range_1 .. range_n -> range.
+// The sort of the result is [domain_i -> range].
+// Panics with an *Error if arrays is empty.
+func Map(function *FunctionDeclaration, arrays ...*AST) *AST {
+	if len(arrays) == 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "array maps need at least one array"})
+	}
+
+	return compute(function.context, func() *AST {
+		args := make([]C.Z3_ast, len(arrays))
+		for i, array := range arrays {
+			args[i] = array.z3AST
+		}
+
+		return function.context.wrapAST(
+			C.Z3_mk_map(
+				function.context.z3Context,
+				function.z3FunctionDeclaration,
+				C.uint(len(args)),
+				pointerTo(args),
+			),
+		)
+	}, function, arrays)
+}
+
+// Access the array default value.
+// Produces the default range value, for arrays that can be represented as
+// finite maps with a default range value.
+func ArrayDefault(array *AST) *AST {
+	return unary(
+		func(context C.Z3_context, array C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_array_default(context, array)
+		}, array,
+	)
+}
+
+// Create array extensionality index given two arrays with the same sort.
+// The meaning is given by the axiom:
+// (=> (= (select lhs (array-ext lhs rhs)) (select rhs (array-ext lhs rhs))) (= lhs rhs))
+func ArrayExt(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_array_ext(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Create array with the same interpretation as a function.
+// The array satisfies the property (f x) = (select (as-array f) x)
+// for every argument x.
+func (function *FunctionDeclaration) AsArray() *AST {
+	return compute(function.context, func() *AST {
+		return function.context.wrapAST(
+			C.Z3_mk_as_array(function.context.z3Context, function.z3FunctionDeclaration),
+		)
+	}, function)
+}
@@ -0,0 +1,93 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayStoreSelect(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	array := context.NewConstant(WithName("a"), context.ArraySort(integer, integer))
+	i := context.NewConstant(WithName("i"), integer)
+	v := context.NewConstant(WithName("v"), integer)
+
+	// Act
+	proven := solver.Proven(Eq(Select(Store(array, i, v), i), v))
+
+	// Assert
+	assert.True(t, proven)
+	assert.Equal(t, KindArray, array.Sort().Kind())
+	assert.Equal(t, KindInt, array.Sort().ArrayRange().Kind())
+}
+
+func TestConstantArray(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	zero := context.NewInt(0, integer)
+	i := context.NewConstant(WithName("i"), integer)
+
+	// Act
+	array := K(integer, zero)
+	proven := solver.Proven(Eq(Select(array, i), zero))
+
+	// Assert
+	assert.True(t, proven)
+	assert.True(t, solver.Proven(Eq(ArrayDefault(array), zero)))
+}
+
+func TestMultiDimensionalArray(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	sort := context.ArraySortN([]*Sort{integer, integer}, integer)
+	array := context.NewConstant(WithName("a"), sort)
+	i := context.NewConstant(WithName("i"), integer)
+	j := context.NewConstant(WithName("j"), integer)
+	v := context.NewConstant(WithName("v"), integer)
+
+	// Act
+	proven := solver.Proven(Eq(SelectN(StoreN(array, []*AST{i, j}, v), i, j), v))
+
+	// Assert
+	assert.True(t, proven)
+	assert.Equal(t, "(select a i j)", SelectN(array, i, j).String())
+}
+
+func TestArrayEmptyOperands(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	array := context.NewConstant(WithName("a"), context.ArraySort(integer, integer))
+	f := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer}, integer)
+	operations := map[string]func(){
+		"ArraySortN": func() { context.ArraySortN(nil, integer) },
+		"SelectN":    func() { SelectN(array) },
+		"StoreN":     func() { StoreN(array, nil, context.NewInt(1, integer)) },
+		"Map":        func() { Map(f) },
+	}
+
+	for name, operation := range operations {
+		// Act
+		_, err := Try(func() bool {
+			operation()
+			return true
+		})
+
+		// Assert
+		var z3Error *Error
+		assert.True(t, errors.As(err, &z3Error), name)
+		assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code, name)
+	}
+}
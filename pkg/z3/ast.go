@@ -29,6 +29,8 @@ type AST struct {
 }
 
 func (context *Context) wrapAST(z3AST C.Z3_ast) *AST {
+	context.check()
+
 	ast := &AST{
 		context: context,
 		z3AST:   z3AST,
@@ -38,7 +40,7 @@ func (context *Context) wrapAST(z3AST C.Z3_ast) *AST {
 	C.Z3_inc_ref(context.z3Context, z3AST)
 	runtime.SetFinalizer(ast, func(ast *AST) {
 		// Make derement of reference counter atomic by wrapping it in a locked state.
-		context.do(func() {
+		context.release(func() {
 			C.Z3_dec_ref(context.z3Context, ast.z3AST)
 		}, ast)
 	})
@@ -79,7 +81,7 @@ func (ast *AST) Substitute(from, to []*AST) *AST {
 func (ast *AST) SubstituteVariables(to []*AST) *AST {
 	length := len(to)
 	context := ast.context
-	cTo := make([]C.Z3_ast, 0, length)
+	cTo := make([]C.Z3_ast, length)
 
 	for idx := range to {
 		cTo[idx] = to[idx].z3AST
@@ -89,7 +91,7 @@ func (ast *AST) SubstituteVariables(to []*AST) *AST {
 		return context.wrapAST(
 			C.Z3_substitute_vars(
 				context.z3Context, ast.z3AST,
-				C.uint(length), &cTo[0],
+				C.uint(length), pointerTo(cTo),
 			),
 		)
 	}, ast, to)
@@ -138,3 +140,128 @@ func (ast *AST) Sort() *Sort {
 		)
 	}, ast)
 }
+
+func (kind ASTKind) String() string {
+	switch kind {
+	case ASTKindApp:
+		return "app"
+	case ASTKindNumeral:
+		return "numeral"
+	case ASTKindVar:
+		return "var"
+	case ASTKindQuantifier:
+		return "quantifier"
+	case ASTKindSort:
+		return "sort"
+	case ASTKindFuncDecl:
+		return "func_decl"
+	}
+	return "unknown"
+}
+
+// Return the kind of the AST.
+func (ast *AST) Kind() ASTKind {
+	return compute(ast.context, func() ASTKind {
+		return ASTKind(C.Z3_get_ast_kind(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return a unique identifier for the AST. The identifier is unique up to structural equality.
+// Thus, two ASTs with the same ID are structurally equal, and the identifier of a live AST does not change.
+func (ast *AST) ID() uint {
+	return compute(ast.context, func() uint {
+		return uint(C.Z3_get_ast_id(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return true if the AST is an application, i.e., a constant, a numeral or a function applied to arguments.
+func (ast *AST) IsApp() bool {
+	return compute(ast.context, func() bool {
+		return bool(C.Z3_is_app(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return true if the AST is a variable bound by a quantifier or lambda.
+func (ast *AST) IsVar() bool {
+	return ast.Kind() == ASTKindVar
+}
+
+// Return true if the AST is a quantifier or lambda.
+func (ast *AST) IsQuantifier() bool {
+	return ast.Kind() == ASTKindQuantifier
+}
+
+// Return the declaration of the function the application is built from, or nil if the AST is not an application.
+func (ast *AST) Decl() *FunctionDeclaration {
+	return compute(ast.context, func() *FunctionDeclaration {
+		z3Context := ast.context.z3Context
+		if !C.Z3_is_app(z3Context, ast.z3AST) {
+			return nil
+		}
+		return ast.context.wrapFunctionDeclaration(
+			C.Z3_get_app_decl(z3Context, C.Z3_to_app(z3Context, ast.z3AST)),
+		)
+	}, ast)
+}
+
+// Return the number of arguments of the application, or 0 if the AST is not an application.
+func (ast *AST) NumArgs() uint {
+	return compute(ast.context, func() uint {
+		z3Context := ast.context.z3Context
+		if !C.Z3_is_app(z3Context, ast.z3AST) {
+			return 0
+		}
+		return uint(C.Z3_get_app_num_args(z3Context, C.Z3_to_app(z3Context, ast.z3AST)))
+	}, ast)
+}
+
+// Return the argument at position idx of the application.
+// Panics with an *Error if the AST is not an application or idx is out of bounds.
+func (ast *AST) Arg(idx uint) *AST {
+	return compute(ast.context, func() *AST {
+		z3Context := ast.context.z3Context
+		return ast.context.wrapAST(
+			C.Z3_get_app_arg(z3Context, C.Z3_to_app(z3Context, ast.z3AST), C.uint(idx)),
+		)
+	}, ast)
+}
+
+// Return all arguments of the application. The result is empty if the AST is not an application.
+func (ast *AST) Args() []*AST {
+	return compute(ast.context, func() []*AST {
+		z3Context := ast.context.z3Context
+		if !C.Z3_is_app(z3Context, ast.z3AST) {
+			return nil
+		}
+		app := C.Z3_to_app(z3Context, ast.z3AST)
+		args := make([]*AST, C.Z3_get_app_num_args(z3Context, app))
+		for idx := range args {
+			args[idx] = ast.context.wrapAST(C.Z3_get_app_arg(z3Context, app, C.uint(idx)))
+		}
+		return args
+	}, ast)
+}
+
+// Return the de-Bruijn index of the bound variable.
+// Panics with an *Error if the AST is not a bound variable.
+func (ast *AST) VarIndex() uint {
+	return compute(ast.context, func() uint {
+		return uint(C.Z3_get_index_value(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return an AST that is the same as the given one, but with its arguments replaced by the given ones.
+// For applications, the number of arguments has to match NumArgs. For quantifiers and lambdas,
+// the single argument replaces the body. Other ASTs are returned unchanged.
+func (ast *AST) Update(args []*AST) *AST {
+	z3Args := make([]C.Z3_ast, len(args))
+	for idx := range args {
+		z3Args[idx] = args[idx].z3AST
+	}
+
+	return compute(ast.context, func() *AST {
+		return ast.context.wrapAST(
+			C.Z3_update_term(ast.context.z3Context, ast.z3AST, C.uint(len(z3Args)), pointerTo(z3Args)),
+		)
+	}, ast, args)
+}
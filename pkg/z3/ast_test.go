@@ -1,6 +1,10 @@
 package z3
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func Test_Substitute(t *testing.T) {
 	// Arrange
@@ -22,3 +26,83 @@ func Test_Substitute(t *testing.T) {
 
 	// Assert
 }
+
+func TestASTApplication(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	formula := Add(x, context.NewInt(1, integer))
+
+	// Act
+	decl := formula.Decl()
+	args := formula.Args()
+
+	// Assert
+	assert.Equal(t, ASTKindApp, formula.Kind())
+	assert.True(t, formula.IsApp())
+	assert.False(t, formula.IsQuantifier())
+	assert.Equal(t, DeclKindAdd, decl.Kind())
+	assert.Equal(t, "+", decl.Kind().String())
+	assert.Equal(t, uint(2), formula.NumArgs())
+	assert.Len(t, args, 2)
+	assert.True(t, args[0].Equals(x))
+	assert.True(t, formula.Arg(1).IsNumeral())
+	assert.Equal(t, ASTKindNumeral, formula.Arg(1).Kind())
+	assert.Equal(t, DeclKindUninterpreted, x.Decl().Kind())
+	assert.Equal(t, "x", x.Decl().Name().String())
+	assert.Equal(t, uint(0), x.NumArgs())
+}
+
+func TestASTID(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+
+	// Act
+	first := Add(x, y)
+	second := Add(x, y)
+
+	// Assert
+	assert.Equal(t, first.ID(), second.ID())
+	assert.NotEqual(t, first.ID(), x.ID())
+}
+
+func TestFunctionDeclarationSignature(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	boolean := context.BooleanSort()
+
+	// Act
+	f := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer, boolean}, integer)
+
+	// Assert
+	assert.Equal(t, "f", f.Name().String())
+	assert.Equal(t, uint(2), f.Arity())
+	assert.Equal(t, KindBoolean, f.Domain(1).Kind())
+	assert.Equal(t, []Kind{KindInt, KindBoolean}, []Kind{f.Domains()[0].Kind(), f.Domains()[1].Kind()})
+	assert.Equal(t, KindInt, f.Range().Kind())
+	assert.Equal(t, "(declare-fun f (Int Bool) Int)", f.String())
+}
+
+func TestDeclKindString(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+	q := context.NewConstant(WithName("q"), context.BooleanSort())
+
+	// Act
+	kinds := []DeclKind{
+		And(p, q).Decl().Kind(),
+		Not(p).Decl().Kind(),
+		ITE(p, q, p).Decl().Kind(),
+	}
+
+	// Assert
+	assert.Equal(t, []DeclKind{DeclKindAnd, DeclKindNot, DeclKindIte}, kinds)
+	assert.Equal(t, "and", DeclKindAnd.String())
+	assert.Equal(t, "unknown", DeclKind(-1).String())
+}
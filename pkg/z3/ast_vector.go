@@ -7,27 +7,19 @@ package z3
 */
 import "C"
 
-type ASTVector struct {
-	context *Context
-	vector  C.Z3_ast_vector
-}
-
-func (context *Context) wrapASTVector(vector C.Z3_ast_vector) *ASTVector {
-	return &ASTVector{
-		context: context,
-		vector:  vector,
-	}
-}
+// Copy the ASTs of the Z3 vector into a slice. Has to be called in a locked state.
+func (context *Context) wrapASTs(vector C.Z3_ast_vector) []*AST {
+	context.check()
 
-func (asts *ASTVector) Length() uint {
-	return uint(C.Z3_ast_vector_size(asts.context.z3Context, asts.vector))
-}
+	// The vector is only kept alive while copying its elements.
+	C.Z3_ast_vector_inc_ref(context.z3Context, vector)
+	defer C.Z3_ast_vector_dec_ref(context.z3Context, vector)
 
-func (asts *ASTVector) Get(index uint) *AST {
-	return asts.context.wrapAST(
-		C.Z3_ast_vector_get(
-			asts.context.z3Context,
-			asts.vector, C.uint(index),
-		),
-	)
+	asts := make([]*AST, C.Z3_ast_vector_size(context.z3Context, vector))
+	for idx := range asts {
+		asts[idx] = context.wrapAST(
+			C.Z3_ast_vector_get(context.z3Context, vector, C.uint(idx)),
+		)
+	}
+	return asts
 }
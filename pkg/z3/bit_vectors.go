@@ -0,0 +1,514 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// Create a bit-vector type of the given size.
+// This type can also be seen as a machine integer.
+//
+// The size of the bit-vector type must be greater than zero.
+func (context *Context) BitVectorSort(width uint) *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_bv_sort(context.z3Context, C.uint(width)),
+		)
+	})
+}
+
+// Return the size of the given bit-vector sort.
+func (sort *Sort) BitVectorWidth() uint {
+	return compute(sort.context, func() uint {
+		return uint(C.Z3_get_bv_sort_size(sort.context.z3Context, sort.z3Sort))
+	}, sort)
+}
+
+// Create a bit-vector numeral of the given width.
+// Negative values are represented in two's complement.
+func (context *Context) NewBitVector(value int64, width uint) *AST {
+	sort := context.BitVectorSort(width)
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_int64(context.z3Context, C.int64_t(value), sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Bitwise negation.
+func BVNot(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvnot(context, operand)
+		}, operand,
+	)
+}
+
+// Take conjunction of bits in vector, return vector of length 1.
+func BVRedAnd(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvredand(context, operand)
+		}, operand,
+	)
+}
+
+// Take disjunction of bits in vector, return vector of length 1.
+func BVRedOr(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvredor(context, operand)
+		}, operand,
+	)
+}
+
+// Bitwise and.
+func BVAnd(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvand(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Bitwise or.
+func BVOr(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvor(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Bitwise exclusive-or.
+func BVXor(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvxor(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Bitwise nand.
+func BVNand(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvnand(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Bitwise nor.
+func BVNor(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvnor(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Bitwise xnor.
+func BVXnor(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvxnor(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Standard two's complement unary minus.
+func BVNegate(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvneg(context, operand)
+		}, operand,
+	)
+}
+
+// Standard two's complement addition.
+func BVAdd(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvadd(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Standard two's complement subtraction.
+func BVSubtract(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsub(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Standard two's complement multiplication.
+func BVMultiply(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvmul(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Unsigned division.
+//
+// It is defined as the floor of lhs/rhs if rhs is different from zero.
+// If rhs is zero, then the result is undefined.
+func BVUnsignedDivide(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvudiv(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed division.
+//
+// It is defined in the following way:
+// - The floor of lhs/rhs if rhs is different from zero, and lhs*rhs >= 0.
+// - The ceiling of lhs/rhs if rhs is different from zero, and lhs*rhs < 0.
+//
+// If rhs is zero, then the result is undefined.
+func BVSignedDivide(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsdiv(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Unsigned remainder.
+//
+// It is defined as lhs - (lhs /u rhs) * rhs, where /u represents unsigned division.
+// If rhs is zero, then the result is undefined.
+func BVUnsignedRemainder(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvurem(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed remainder (sign follows dividend).
+//
+// It is defined as lhs - (lhs /s rhs) * rhs, where /s represents signed division.
+// The most significant bit (sign) of the result is equal to the most significant bit of lhs.
+// If rhs is zero, then the result is undefined.
+func BVSignedRemainder(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsrem(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed remainder (sign follows divisor).
+// If rhs is zero, then the result is undefined.
+func BVSignedModulus(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsmod(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Unsigned less than.
+func BVULT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvult(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed less than.
+func BVSLT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvslt(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Unsigned less than or equal to.
+func BVULE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvule(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed less than or equal to.
+func BVSLE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsle(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Unsigned greater than or equal to.
+func BVUGE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvuge(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed greater than or equal to.
+func BVSGE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsge(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Unsigned greater than.
+func BVUGT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvugt(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Two's complement signed greater than.
+func BVSGT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsgt(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Concatenate the given bit-vectors.
+//
+// The result is a bit-vector of size n1+n2, where n1 (n2) is the size of lhs (rhs).
+func BVConcat(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_concat(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Extract the bits high down to low from a bit-vector of size m to yield
+// a new bit-vector of size n, where n = high - low + 1.
+func BVExtract(high, low uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_extract(context, C.uint(high), C.uint(low), operand)
+		}, operand,
+	)
+}
+
+// Sign-extend of the given bit-vector to the (signed) equivalent bit-vector of size m+amount,
+// where m is the size of the given bit-vector.
+func BVSignExtend(amount uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_sign_ext(context, C.uint(amount), operand)
+		}, operand,
+	)
+}
+
+// Extend the given bit-vector with zeros to the (unsigned) equivalent bit-vector of size m+amount,
+// where m is the size of the given bit-vector.
+func BVZeroExtend(amount uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_zero_ext(context, C.uint(amount), operand)
+		}, operand,
+	)
+}
+
+// Repeat the given bit-vector count times, i.e., concatenate count copies of it.
+func BVRepeat(count uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_repeat(context, C.uint(count), operand)
+		}, operand,
+	)
+}
+
+// Shift left.
+//
+// It is equivalent to multiplication by 2^x where x is the value of rhs.
+func BVShiftLeft(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvshl(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Logical shift right.
+//
+// It is equivalent to unsigned division by 2^x where x is the value of rhs.
+func BVLogicalShiftRight(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvlshr(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Arithmetic shift right.
+//
+// It is like logical shift right except that the most significant
+// bits of the result always copy the most significant bit of lhs.
+func BVArithmeticShiftRight(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvashr(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Rotate bits of the given bit-vector amount times to the left.
+func BVRotateLeftBy(amount uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_rotate_left(context, C.uint(amount), operand)
+		}, operand,
+	)
+}
+
+// Rotate bits of the given bit-vector amount times to the right.
+func BVRotateRightBy(amount uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_rotate_right(context, C.uint(amount), operand)
+		}, operand,
+	)
+}
+
+// Rotate bits of lhs to the left rhs times.
+func BVRotateLeft(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_ext_rotate_left(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Rotate bits of lhs to the right rhs times.
+func BVRotateRight(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_ext_rotate_right(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Create an integer from the bit-vector argument.
+//
+// If signed is false, then the bit-vector is treated as unsigned.
+// So the result is non-negative and in the range [0..2^N-1], where N are the number of bits in operand.
+// If signed is true, then the bit-vector is treated as a signed number.
+// So the result is in the range [-2^(N-1)..2^(N-1)-1].
+func BVToInt(operand *AST, signed bool) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bv2int(context, operand, C.bool(signed))
+		}, operand,
+	)
+}
+
+// Create a bit-vector of the given width from the integer argument.
+//
+// The result is the integer modulo 2^width.
+func IntToBV(width uint, operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_int2bv(context, C.uint(width), operand)
+		}, operand,
+	)
+}
+
+// Create a predicate that checks that the bit-wise addition
+// of lhs and rhs does not overflow.
+func BVAddNoOverflow(lhs, rhs *AST, signed bool) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvadd_no_overflow(context, lhs, rhs, C.bool(signed))
+		}, lhs, rhs,
+	)
+}
+
+// Create a predicate that checks that the bit-wise signed addition
+// of lhs and rhs does not underflow.
+func BVAddNoUnderflow(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvadd_no_underflow(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Create a predicate that checks that the bit-wise signed subtraction
+// of lhs and rhs does not overflow.
+func BVSubtractNoOverflow(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsub_no_overflow(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Create a predicate that checks that the bit-wise subtraction
+// of lhs and rhs does not underflow.
+func BVSubtractNoUnderflow(lhs, rhs *AST, signed bool) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsub_no_underflow(context, lhs, rhs, C.bool(signed))
+		}, lhs, rhs,
+	)
+}
+
+// Create a predicate that checks that the bit-wise signed division
+// of lhs and rhs does not overflow.
+func BVSignedDivideNoOverflow(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvsdiv_no_overflow(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Check that bit-wise negation does not overflow when
+// the operand is interpreted as a signed bit-vector.
+func BVNegateNoOverflow(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvneg_no_overflow(context, operand)
+		}, operand,
+	)
+}
+
+// Create a predicate that checks that the bit-wise multiplication
+// of lhs and rhs does not overflow.
+func BVMultiplyNoOverflow(lhs, rhs *AST, signed bool) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvmul_no_overflow(context, lhs, rhs, C.bool(signed))
+		}, lhs, rhs,
+	)
+}
+
+// Create a predicate that checks that the bit-wise signed multiplication
+// of lhs and rhs does not underflow.
+func BVMultiplyNoUnderflow(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_bvmul_no_underflow(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
@@ -0,0 +1,159 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitVectorString(t *testing.T) {
+	var context *Context
+
+	bvV := func(identifier string) *AST {
+		return context.NewConstant(WithName(identifier), context.BitVectorSort(8))
+	}
+	bvC := func(value int64) *AST {
+		return context.NewBitVector(value, 8)
+	}
+
+	tests := []struct {
+		name      string
+		operation func() *AST
+		text      string
+		kind      Kind
+	}{
+		{
+			name: "Bit-vector numeral",
+			operation: func() *AST {
+				return bvC(10)
+			},
+			text: "#x0a",
+			kind: KindBitVector,
+		},
+		{
+			name: "Negative bit-vector numeral",
+			operation: func() *AST {
+				return bvC(-1)
+			},
+			text: "#xff",
+			kind: KindBitVector,
+		},
+		{
+			name: "Bit-vector addition",
+			operation: func() *AST {
+				return BVAdd(bvV("p"), bvV("q"))
+			},
+			text: "(bvadd p q)",
+			kind: KindBitVector,
+		},
+		{
+			name: "Bit-vector signed division",
+			operation: func() *AST {
+				return BVSignedDivide(bvV("p"), bvV("q"))
+			},
+			text: "(bvsdiv p q)",
+			kind: KindBitVector,
+		},
+		{
+			name: "Bit-vector unsigned less than",
+			operation: func() *AST {
+				return BVULT(bvV("p"), bvV("q"))
+			},
+			text: "(bvult p q)",
+			kind: KindBoolean,
+		},
+		{
+			name: "Bit-vector concatenation",
+			operation: func() *AST {
+				return BVConcat(bvV("p"), bvV("q"))
+			},
+			text: "(concat p q)",
+			kind: KindBitVector,
+		},
+		{
+			name: "Bit-vector extraction",
+			operation: func() *AST {
+				return BVExtract(3, 0, bvV("p"))
+			},
+			text: "((_ extract 3 0) p)",
+			kind: KindBitVector,
+		},
+		{
+			name: "Bit-vector zero extension",
+			operation: func() *AST {
+				return BVZeroExtend(8, bvV("p"))
+			},
+			text: "((_ zero_extend 8) p)",
+			kind: KindBitVector,
+		},
+		{
+			name: "Bit-vector rotation",
+			operation: func() *AST {
+				return BVRotateLeftBy(2, bvV("p"))
+			},
+			text: "((_ rotate_left 2) p)",
+			kind: KindBitVector,
+		},
+	}
+
+	for _, test := range tests {
+		// For each test we create a new context such that the factory methods create "fresh" ASTs.
+		config := NewConfig()
+		context = NewContext(config)
+		ast := test.operation()
+		kind := ast.Sort().Kind()
+
+		assert.Equal(t, test.text, ast.String(), test.name)
+		assert.Equal(t, test.kind, kind, test.name)
+	}
+}
+
+func TestBitVectorOverflow(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	one := context.NewBitVector(1, 8)
+
+	// Act
+	wraps := solver.HasSolutionFor(BVULT(BVAdd(x, one), x))
+
+	// Assert
+	assert.True(t, wraps)
+	assert.Equal(t, uint(8), x.Sort().BitVectorWidth())
+}
+
+func TestBitVectorAdditionAgreesWithIntegers(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	y := context.NewConstant(WithName("y"), context.BitVectorSort(8))
+
+	// Act
+	solver.Assert(BVAddNoOverflow(x, y, false))
+	proven := solver.Proven(
+		Eq(BVToInt(BVAdd(x, y), false), Add(BVToInt(x, false), BVToInt(y, false))),
+	)
+
+	// Assert
+	assert.True(t, proven)
+}
+
+func TestBitVectorNegateOverflows(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+
+	// Act
+	model := solver.Prove(BVNegateNoOverflow(x))
+	_, value := model.Eval(x, true)
+
+	// Assert
+	assert.Equal(t, "#x80", value.String())
+	assert.Equal(t, "#x80", IntToBV(8, context.NewInt(-128, context.IntegerSort())).Simplify().String())
+}
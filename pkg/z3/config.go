@@ -4,9 +4,17 @@ package z3
 #cgo CFLAGS: -I../../modules/z3
 #cgo LDFLAGS: -L../../modules/z3 -lz3
 #include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
 */
 import "C"
-import "runtime"
+import (
+	"math"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+)
 
 // Configuration object used to initialize logical contexts.
 type Config struct {
@@ -41,3 +49,124 @@ func NewConfig() *Config {
 
 	return config
 }
+
+// Encoding of the characters of strings.
+type Encoding string
+
+const (
+	EncodingUnicode = Encoding("unicode") // 18 bit characters
+	EncodingBMP     = Encoding("bmp")     // 16 bit characters
+	EncodingASCII   = Encoding("ascii")   // 8 bit characters
+)
+
+// Names of the parameters that can be set on a configuration.
+// Z3 ignores all other parameters and only prints a warning.
+var configParams = map[string]bool{
+	"auto_config":       true,
+	"debug_ref_count":   true,
+	"dump_models":       true,
+	"encoding":          true,
+	"model":             true,
+	"model_validate":    true,
+	"proof":             true,
+	"rlimit":            true,
+	"smtlib2_compliant": true,
+	"stats":             true,
+	"timeout":           true,
+	"trace":             true,
+	"trace_file_name":   true,
+	"type_check":        true,
+	"unsat_core":        true,
+	"well_sorted_check": true,
+}
+
+// Set a configuration parameter.
+// The list of all configuration parameters can be obtained using the Z3 executable: z3 -p
+//
+// Returns an *Error if the key is not the name of a configuration parameter. Like Z3, the key is
+// case-insensitive and dashes may be used instead of underscores. The value is not validated.
+// Note that the available parameters depend on the version of Z3, e.g., older versions do not support encoding.
+func (config *Config) Set(key, value string) error {
+	if !configParams[strings.ReplaceAll(strings.ToLower(key), "-", "_")] {
+		return &Error{Code: ErrorCodeInvalidArgument, Message: "unknown configuration parameter: " + key}
+	}
+	config.set(key, value)
+	return nil
+}
+
+func (config *Config) set(key, value string) {
+	// Allocate unmanged strings and make sure they are freed.
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	cValue := C.CString(value)
+	defer C.free(unsafe.Pointer(cValue))
+
+	C.Z3_set_param_value(config.z3Config, cKey, cValue)
+	runtime.KeepAlive(config)
+}
+
+// Enable or disable proof generation for contexts created with the configuration.
+func (config *Config) SetProof(enabled bool) {
+	config.set("proof", strconv.FormatBool(enabled))
+}
+
+// Enable or disable debug support for Z3_ast reference counting.
+func (config *Config) SetDebugRefCount(enabled bool) {
+	config.set("debug_ref_count", strconv.FormatBool(enabled))
+}
+
+// Enable or disable tracing support for VCC.
+func (config *Config) SetTrace(enabled bool) {
+	config.set("trace", strconv.FormatBool(enabled))
+}
+
+// Set the trace out file for VCC traces.
+func (config *Config) SetTraceFileName(name string) {
+	config.set("trace_file_name", name)
+}
+
+// Set the default timeout used for solvers. The timeout has a resolution of milliseconds
+// and is limited to the maximum supported by Z3, which is about 49 days.
+// Panics with an *Error if the timeout is negative.
+func (config *Config) SetTimeout(timeout time.Duration) {
+	if timeout < 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "timeouts must not be negative"})
+	}
+	milliseconds := timeout.Milliseconds()
+	if milliseconds > math.MaxUint32 {
+		milliseconds = math.MaxUint32
+	}
+	config.set("timeout", strconv.FormatInt(milliseconds, 10))
+}
+
+// Enable or disable the type checker.
+func (config *Config) SetWellSortedCheck(enabled bool) {
+	config.set("well_sorted_check", strconv.FormatBool(enabled))
+}
+
+// Enable or disable the heuristics to automatically select solver and configure it.
+func (config *Config) SetAutoConfig(enabled bool) {
+	config.set("auto_config", strconv.FormatBool(enabled))
+}
+
+// Enable or disable model generation for solvers.
+// This parameter can be overwritten when creating a solver.
+func (config *Config) SetModel(enabled bool) {
+	config.set("model", strconv.FormatBool(enabled))
+}
+
+// Enable or disable the validation of models produced by solvers.
+func (config *Config) SetModelValidate(enabled bool) {
+	config.set("model_validate", strconv.FormatBool(enabled))
+}
+
+// Enable or disable unsat-core generation for solvers.
+// This parameter can be overwritten when creating a solver.
+func (config *Config) SetUnsatCore(enabled bool) {
+	config.set("unsat_core", strconv.FormatBool(enabled))
+}
+
+// Set the string encoding used internally.
+func (config *Config) SetEncoding(encoding Encoding) {
+	config.set("encoding", string(encoding))
+}
@@ -4,13 +4,12 @@ package z3
 #cgo CFLAGS: -I../../modules/z3
 #cgo LDFLAGS: -L../../modules/z3 -lz3
 #include "../../modules/z3/src/api/z3.h"
-#include <stdlib.h>
 */
 import "C"
 import (
+	gocontext "context"
 	"runtime"
 	"sync"
-	"unsafe"
 )
 
 // Manager of all other Z3 objects, global configuration options, etc.
@@ -42,6 +41,11 @@ func NewContext(config *Config) *Context {
 		z3Context: C.Z3_mk_context_rc(config.z3Config),
 	}
 
+	// Without an error handler, Z3 only records the error status of an operation.
+	// The status is checked after every operation and raised as a panic with an *Error.
+	// The default handler of Z3 would terminate the process instead.
+	C.Z3_set_error_handler(context.z3Context, nil)
+
 	// Before GC of the context we want to delete the C unmanaged context object.
 	runtime.SetFinalizer(context, func(context *Context) {
 		C.Z3_del_context(context.z3Context)
@@ -64,7 +68,36 @@ func (context *Context) Interrupt() {
 	runtime.KeepAlive(context)
 }
 
+// Perform the operation and interrupt it if the Go context is done before the operation completes.
+// The operation is skipped if the Go context is done already. Returns whether the Go context is done.
+//
+// The operation itself acquires the mutex, while the interrupt does not. Hence, the interrupting goroutine is never
+// blocked by the running operation. The goroutine is awaited, so that it cannot interrupt any later operation.
+func interruptible[T any](context *Context, ctx gocontext.Context, operation func() T) (value T, done bool) {
+	if ctx.Err() != nil {
+		return value, true
+	}
+
+	finished := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			context.Interrupt()
+		case <-finished:
+		}
+	}()
+
+	value = operation()
+	close(finished)
+	<-stopped
+
+	return value, ctx.Err() != nil
+}
+
 // Aquires the mutex lock necessary for performing AST operations from the context.
+// Panics with an *Error if the operation has an error status.
 func (context *Context) do(action func(), keeps ...any) {
 	context.mutex.Lock()
 	defer func() {
@@ -74,6 +107,20 @@ func (context *Context) do(action func(), keeps ...any) {
 		}
 	}()
 	action()
+	context.check()
+}
+
+// Aquires the mutex lock necessary for releasing Z3 objects from a finalizer.
+// In contrast to do, the error status is not checked, as a panic on the finalizer goroutine terminates the program.
+func (context *Context) release(action func(), keeps ...any) {
+	context.mutex.Lock()
+	defer func() {
+		context.mutex.Unlock()
+		for _, keep := range keeps {
+			runtime.KeepAlive(keep)
+		}
+	}()
+	action()
 }
 
 func compute[T any](context *Context, function func() T, keeps ...any) T {
@@ -86,18 +133,13 @@ func compute[T any](context *Context, function func() T, keeps ...any) T {
 	return value
 }
 
-func (context *Context) Parse(str string) *ASTVector {
-	// Allocate an unmanged string and make sure it is freed.
-	cStr := C.CString(str)
-	defer C.free(unsafe.Pointer(cStr))
-
-	return context.wrapASTVector(
-		C.Z3_parse_smtlib2_string(
-			context.z3Context, cStr,
-			0, nil, nil,
-			0, nil, nil,
-		),
-	)
+// Return a pointer to the first element of the given slice or nil if it is empty.
+// Z3 accepts nil for arrays of length zero, whereas taking the address of the first element would panic.
+func pointerTo[T any](slice []T) *T {
+	if len(slice) == 0 {
+		return nil
+	}
+	return &slice[0]
 }
 
 func (context *Context) NewFunctionDeclaration(symbolFactory SymbolFactory, inputs []*Sort, output *Sort) *FunctionDeclaration {
@@ -113,7 +155,7 @@ func (context *Context) NewFunctionDeclaration(symbolFactory SymbolFactory, inpu
 				context.z3Context,
 				symbol.z3Symbol,
 				C.uint(len(domain)),
-				&domain[0],
+				pointerTo(domain),
 				output.z3Sort,
 			),
 		)
@@ -148,7 +190,7 @@ func (context *Context) NewReal(numerator, denominator int) *AST {
 func (context *Context) NewInt(value int, sort *Sort) *AST {
 	return compute(context, func() *AST {
 		return context.wrapAST(
-			C.Z3_mk_int(context.z3Context, C.int(value), sort.z3Sort),
+			C.Z3_mk_int64(context.z3Context, C.int64_t(value), sort.z3Sort),
 		)
 	}, sort)
 }
@@ -161,13 +203,17 @@ func (context *Context) NewBoolean(value bool) *AST {
 }
 
 func (context *Context) NewTrue() *AST {
-	return context.wrapAST(
-		C.Z3_mk_true(context.z3Context),
-	)
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_true(context.z3Context),
+		)
+	})
 }
 
 func (context *Context) NewFalse() *AST {
-	return context.wrapAST(
-		C.Z3_mk_false(context.z3Context),
-	)
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_false(context.z3Context),
+		)
+	})
 }
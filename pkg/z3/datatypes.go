@@ -0,0 +1,369 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+import "runtime"
+
+// Field of a tuple or of a datatype constructor.
+type Field struct {
+	// Name of the accessor (projection) function of the field.
+	Name SymbolFactory
+
+	// Sort of the field. It is nil if the field refers to one of the
+	// (mutually) recursive datatypes that are being declared.
+	Sort *Sort
+
+	// Index of the datatype that is being declared and referred to by the field.
+	// Only used if Sort is nil.
+	SortReference uint
+}
+
+// Container of a constructor declaration used to create datatypes.
+type Constructor struct {
+	context       *Context
+	z3Constructor C.Z3_constructor
+	fields        uint
+}
+
+// Create a constructor with the given name, the name of its recognizer function and its fields.
+//
+// The constructor can be queried for its function declarations after it was passed to
+// DatatypeSort or DatatypeSorts.
+func (context *Context) NewConstructor(name, recognizer SymbolFactory, fields ...Field) *Constructor {
+	nameSymbol := name(context)
+	recognizerSymbol := recognizer(context)
+	fieldNames := make([]C.Z3_symbol, len(fields))
+	for idx := range fields {
+		fieldNames[idx] = fields[idx].Name(context).z3Symbol
+	}
+
+	constructor := compute(context, func() *Constructor {
+		sorts := make([]C.Z3_sort, len(fields))
+		sortReferences := make([]C.uint, len(fields))
+		for idx, field := range fields {
+			if field.Sort != nil {
+				sorts[idx] = field.Sort.z3Sort
+			}
+			sortReferences[idx] = C.uint(field.SortReference)
+		}
+
+		return &Constructor{
+			context: context,
+			z3Constructor: C.Z3_mk_constructor(
+				context.z3Context,
+				nameSymbol.z3Symbol,
+				recognizerSymbol.z3Symbol,
+				C.uint(len(fields)),
+				pointerTo(fieldNames),
+				pointerTo(sorts),
+				pointerTo(sortReferences),
+			),
+			fields: uint(len(fields)),
+		}
+	}, fields)
+
+	runtime.SetFinalizer(constructor, func(constructor *Constructor) {
+		context.release(func() {
+			C.Z3_del_constructor(context.z3Context, constructor.z3Constructor)
+		})
+	})
+
+	return constructor
+}
+
+// Query the constructor for its declared functions. That is, the constructor function itself,
+// the recognizer predicate and the accessors of its fields.
+//
+// The constructor must have been passed to DatatypeSort or DatatypeSorts.
+func (constructor *Constructor) Query() (
+	function *FunctionDeclaration, recognizer *FunctionDeclaration, accessors []*FunctionDeclaration,
+) {
+	context := constructor.context
+	context.do(func() {
+		var z3Function, z3Recognizer C.Z3_func_decl
+		z3Accessors := make([]C.Z3_func_decl, constructor.fields)
+
+		C.Z3_query_constructor(
+			context.z3Context,
+			constructor.z3Constructor,
+			C.uint(constructor.fields),
+			&z3Function, &z3Recognizer,
+			pointerTo(z3Accessors),
+		)
+		context.check()
+
+		function = context.wrapFunctionDeclaration(z3Function)
+		recognizer = context.wrapFunctionDeclaration(z3Recognizer)
+		accessors = make([]*FunctionDeclaration, len(z3Accessors))
+		for idx := range z3Accessors {
+			accessors[idx] = context.wrapFunctionDeclaration(z3Accessors[idx])
+		}
+	}, constructor)
+
+	return
+}
+
+// Create datatype, such as lists, trees, records, enumerations or unions of records.
+// The datatype may be recursive, in which case fields refer to it with a nil sort
+// and a sort reference of zero.
+func (context *Context) DatatypeSort(name SymbolFactory, constructors ...*Constructor) *Sort {
+	symbol := name(context)
+	return compute(context, func() *Sort {
+		z3Constructors := make([]C.Z3_constructor, len(constructors))
+		for idx := range constructors {
+			z3Constructors[idx] = constructors[idx].z3Constructor
+		}
+
+		return context.wrapSort(
+			C.Z3_mk_datatype(
+				context.z3Context,
+				symbol.z3Symbol,
+				C.uint(len(z3Constructors)),
+				pointerTo(z3Constructors),
+			),
+		)
+	}, constructors)
+}
+
+// Create mutually recursive datatypes.
+// The i-th datatype is named names[i] and has the constructors constructors[i].
+// Fields refer to the i-th datatype with a nil sort and a sort reference of i.
+// Panics with an *Error if the number of names and constructor lists differ.
+func (context *Context) DatatypeSorts(names []SymbolFactory, constructors [][]*Constructor) []*Sort {
+	if len(names) != len(constructors) {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "datatype names and constructors must have the same length"})
+	}
+
+	symbols := make([]C.Z3_symbol, len(names))
+	for idx := range names {
+		symbols[idx] = names[idx](context).z3Symbol
+	}
+
+	return compute(context, func() []*Sort {
+		z3Sorts := make([]C.Z3_sort, len(names))
+		z3Lists := make([]C.Z3_constructor_list, len(names))
+
+		// The constructor lists are only containers and can be reclaimed right away.
+		// The constructors themselves are reclaimed by their finalizers.
+		defer func() {
+			for idx := range z3Lists {
+				if z3Lists[idx] != nil {
+					C.Z3_del_constructor_list(context.z3Context, z3Lists[idx])
+				}
+			}
+		}()
+
+		for idx := range constructors {
+			z3Constructors := make([]C.Z3_constructor, len(constructors[idx]))
+			for jdx := range constructors[idx] {
+				z3Constructors[jdx] = constructors[idx][jdx].z3Constructor
+			}
+
+			z3Lists[idx] = C.Z3_mk_constructor_list(
+				context.z3Context,
+				C.uint(len(z3Constructors)),
+				pointerTo(z3Constructors),
+			)
+			context.check()
+		}
+
+		C.Z3_mk_datatypes(
+			context.z3Context,
+			C.uint(len(symbols)),
+			pointerTo(symbols),
+			pointerTo(z3Sorts),
+			pointerTo(z3Lists),
+		)
+		context.check()
+
+		sorts := make([]*Sort, len(z3Sorts))
+		for idx := range z3Sorts {
+			sorts[idx] = context.wrapSort(z3Sorts[idx])
+		}
+		return sorts
+	}, constructors)
+}
+
+// Create a enumeration sort.
+//
+// An enumeration sort with n elements.
+// This function will also declare the functions corresponding to the enumerations.
+//
+// For example, if this function is called with three symbols A, B, C and the name S, then
+// the sort has the name S, and the function returns three constants corresponding to A, B, C.
+// The testers are three predicates of type (S -> Bool). The first predicate (corresponding to A)
+// is true when applied to A, and false otherwise. Similarly for the other predicates.
+func (context *Context) EnumerationSort(name SymbolFactory, elements ...SymbolFactory) (
+	sort *Sort, constants []*FunctionDeclaration, testers []*FunctionDeclaration,
+) {
+	symbol := name(context)
+	symbols := make([]C.Z3_symbol, len(elements))
+	for idx := range elements {
+		symbols[idx] = elements[idx](context).z3Symbol
+	}
+
+	context.do(func() {
+		z3Constants := make([]C.Z3_func_decl, len(elements))
+		z3Testers := make([]C.Z3_func_decl, len(elements))
+
+		sort = context.wrapSort(
+			C.Z3_mk_enumeration_sort(
+				context.z3Context,
+				symbol.z3Symbol,
+				C.uint(len(symbols)),
+				pointerTo(symbols),
+				pointerTo(z3Constants),
+				pointerTo(z3Testers),
+			),
+		)
+
+		constants = make([]*FunctionDeclaration, len(elements))
+		testers = make([]*FunctionDeclaration, len(elements))
+		for idx := range elements {
+			constants[idx] = context.wrapFunctionDeclaration(z3Constants[idx])
+			testers[idx] = context.wrapFunctionDeclaration(z3Testers[idx])
+		}
+	})
+
+	return
+}
+
+// Create a tuple type.
+//
+// A tuple with n fields has a constructor and n projections.
+// This function will also declare the constructor and projection functions.
+// The sort references of the fields are ignored.
+func (context *Context) TupleSort(name SymbolFactory, fields ...Field) (
+	sort *Sort, constructor *FunctionDeclaration, projections []*FunctionDeclaration,
+) {
+	symbol := name(context)
+	fieldNames := make([]C.Z3_symbol, len(fields))
+	for idx := range fields {
+		fieldNames[idx] = fields[idx].Name(context).z3Symbol
+	}
+
+	context.do(func() {
+		fieldSorts := make([]C.Z3_sort, len(fields))
+		for idx := range fields {
+			fieldSorts[idx] = fields[idx].Sort.z3Sort
+		}
+
+		var z3Constructor C.Z3_func_decl
+		z3Projections := make([]C.Z3_func_decl, len(fields))
+
+		sort = context.wrapSort(
+			C.Z3_mk_tuple_sort(
+				context.z3Context,
+				symbol.z3Symbol,
+				C.uint(len(fields)),
+				pointerTo(fieldNames),
+				pointerTo(fieldSorts),
+				&z3Constructor,
+				pointerTo(z3Projections),
+			),
+		)
+
+		constructor = context.wrapFunctionDeclaration(z3Constructor)
+		projections = make([]*FunctionDeclaration, len(fields))
+		for idx := range z3Projections {
+			projections[idx] = context.wrapFunctionDeclaration(z3Projections[idx])
+		}
+	}, fields)
+
+	return
+}
+
+// Function declarations of a list sort.
+type ListDeclarations struct {
+	Nil, IsNil   *FunctionDeclaration
+	Cons, IsCons *FunctionDeclaration
+	Head, Tail   *FunctionDeclaration
+}
+
+// Create a list sort over the given element sort.
+// This function declares the corresponding constructors and testers for lists.
+func (context *Context) ListSort(name SymbolFactory, element *Sort) (sort *Sort, declarations ListDeclarations) {
+	symbol := name(context)
+	context.do(func() {
+		var empty, isNil, cons, isCons, head, tail C.Z3_func_decl
+
+		sort = context.wrapSort(
+			C.Z3_mk_list_sort(
+				context.z3Context,
+				symbol.z3Symbol,
+				element.z3Sort,
+				&empty, &isNil,
+				&cons, &isCons,
+				&head, &tail,
+			),
+		)
+
+		declarations = ListDeclarations{
+			Nil:    context.wrapFunctionDeclaration(empty),
+			IsNil:  context.wrapFunctionDeclaration(isNil),
+			Cons:   context.wrapFunctionDeclaration(cons),
+			IsCons: context.wrapFunctionDeclaration(isCons),
+			Head:   context.wrapFunctionDeclaration(head),
+			Tail:   context.wrapFunctionDeclaration(tail),
+		}
+	}, element)
+
+	return
+}
+
+// Return the constructors of the given datatype sort.
+func (sort *Sort) DatatypeConstructors() []*FunctionDeclaration {
+	return compute(sort.context, func() []*FunctionDeclaration {
+		z3Context := sort.context.z3Context
+		count := C.Z3_get_datatype_sort_num_constructors(z3Context, sort.z3Sort)
+		constructors := make([]*FunctionDeclaration, count)
+		for idx := range constructors {
+			constructors[idx] = sort.context.wrapFunctionDeclaration(
+				C.Z3_get_datatype_sort_constructor(z3Context, sort.z3Sort, C.uint(idx)),
+			)
+		}
+		return constructors
+	}, sort)
+}
+
+// Return the recognizers of the given datatype sort.
+// The i-th recognizer belongs to the i-th constructor.
+func (sort *Sort) DatatypeRecognizers() []*FunctionDeclaration {
+	return compute(sort.context, func() []*FunctionDeclaration {
+		z3Context := sort.context.z3Context
+		count := C.Z3_get_datatype_sort_num_constructors(z3Context, sort.z3Sort)
+		recognizers := make([]*FunctionDeclaration, count)
+		for idx := range recognizers {
+			recognizers[idx] = sort.context.wrapFunctionDeclaration(
+				C.Z3_get_datatype_sort_recognizer(z3Context, sort.z3Sort, C.uint(idx)),
+			)
+		}
+		return recognizers
+	}, sort)
+}
+
+// Return the accessors of the fields of the constructor with the given index.
+// Panics with an *Error if the sort is not a datatype sort or the index is out of bounds.
+func (sort *Sort) DatatypeAccessors(constructor uint) []*FunctionDeclaration {
+	return compute(sort.context, func() []*FunctionDeclaration {
+		z3Context := sort.context.z3Context
+		z3Constructor := C.Z3_get_datatype_sort_constructor(z3Context, sort.z3Sort, C.uint(constructor))
+		// An invalid index yields no constructor, which must not be passed on to Z3.
+		sort.context.check()
+		arity := C.Z3_get_arity(z3Context, z3Constructor)
+		sort.context.check()
+		accessors := make([]*FunctionDeclaration, arity)
+		for idx := range accessors {
+			accessors[idx] = sort.context.wrapFunctionDeclaration(
+				C.Z3_get_datatype_sort_constructor_accessor(
+					z3Context, sort.z3Sort, C.uint(constructor), C.uint(idx),
+				),
+			)
+		}
+		return accessors
+	}, sort)
+}
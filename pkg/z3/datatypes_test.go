@@ -0,0 +1,140 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumerationSort(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	color, constants, testers := context.EnumerationSort(
+		WithName("Color"), WithName("Red"), WithName("Green"), WithName("Blue"),
+	)
+	x := context.NewConstant(WithName("x"), color)
+	red := constants[0].Application(nil)
+	green := constants[1].Application(nil)
+	blue := constants[2].Application(nil)
+
+	// Act
+	proven := solver.Proven(Or(Eq(x, red), Eq(x, green), Eq(x, blue)))
+
+	// Assert
+	assert.True(t, proven)
+	assert.Equal(t, KindDatatype, color.Kind())
+	// The printed form of testers differs between Z3 versions, so compare their structure instead.
+	isRed := testers[0].Application([]*AST{x})
+	assert.Equal(t, DeclKindDatatypeIs, isRed.Decl().Kind())
+	assert.True(t, isRed.Arg(0).Equals(x))
+	assert.Equal(t, "true", testers[0].Application([]*AST{red}).Simplify().String())
+	assert.Equal(t, "false", testers[0].Application([]*AST{green}).Simplify().String())
+}
+
+func TestTupleSort(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	pair, constructor, projections := context.TupleSort(
+		WithName("Pair"),
+		Field{Name: WithName("first"), Sort: integer},
+		Field{Name: WithName("second"), Sort: context.BooleanSort()},
+	)
+	x := context.NewConstant(WithName("x"), integer)
+	p := constructor.Application([]*AST{x, context.NewTrue()})
+
+	// Act
+	proven := solver.Proven(Eq(projections[0].Application([]*AST{p}), x))
+
+	// Assert
+	assert.True(t, proven)
+	assert.Len(t, pair.DatatypeAccessors(0), 2)
+	_, err := Try(func() []*FunctionDeclaration { return pair.DatatypeAccessors(1) })
+	var z3Error *Error
+	assert.True(t, errors.As(err, &z3Error))
+}
+
+func TestRecursiveDatatype(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	leaf := context.NewConstructor(WithName("leaf"), WithName("is-leaf"))
+	node := context.NewConstructor(
+		WithName("node"), WithName("is-node"),
+		Field{Name: WithName("left"), SortReference: 0},
+		Field{Name: WithName("value"), Sort: integer},
+		Field{Name: WithName("right"), SortReference: 0},
+	)
+	tree := context.DatatypeSort(WithName("Tree"), leaf, node)
+	mkLeaf, _, _ := leaf.Query()
+	mkNode, isNode, accessors := node.Query()
+	x := context.NewConstant(WithName("x"), integer)
+	empty := mkLeaf.Application(nil)
+
+	// Act
+	t1 := mkNode.Application([]*AST{empty, x, empty})
+	proven := solver.Proven(And(isNode.Application([]*AST{t1}), Eq(accessors[1].Application([]*AST{t1}), x)))
+
+	// Assert
+	assert.True(t, proven)
+	assert.Len(t, tree.DatatypeConstructors(), 2)
+	assert.Equal(t, "(node leaf x leaf)", t1.String())
+}
+
+func TestMutuallyRecursiveDatatypes(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	empty := context.NewConstructor(WithName("empty"), WithName("is-empty"))
+	insert := context.NewConstructor(
+		WithName("insert"), WithName("is-insert"),
+		Field{Name: WithName("head"), SortReference: 1},
+		Field{Name: WithName("tail"), SortReference: 0},
+	)
+	tree := context.NewConstructor(
+		WithName("tree"), WithName("is-tree"),
+		Field{Name: WithName("children"), SortReference: 0},
+	)
+
+	// Act
+	sorts := context.DatatypeSorts(
+		[]SymbolFactory{WithName("Forest"), WithName("Tree")},
+		[][]*Constructor{{empty, insert}, {tree}},
+	)
+	mkEmpty, _, _ := empty.Query()
+	mkTree, _, children := tree.Query()
+	leaf := mkTree.Application([]*AST{mkEmpty.Application(nil)})
+
+	// Assert
+	assert.Len(t, sorts, 2)
+	assert.True(t, sorts[1].SameAs(leaf.Sort()))
+	assert.True(t, solver.Proven(Eq(children[0].Application([]*AST{leaf}), mkEmpty.Application(nil))))
+	_, err := Try(func() []*Sort { return context.DatatypeSorts([]SymbolFactory{WithName("Forest")}, nil) })
+	var z3Error *Error
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+}
+
+func TestListSort(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	_, list := context.ListSort(WithName("IntList"), integer)
+	x := context.NewConstant(WithName("x"), integer)
+
+	// Act
+	cell := list.Cons.Application([]*AST{x, list.Nil.Application(nil)})
+
+	// Assert
+	assert.True(t, solver.Proven(Eq(list.Head.Application([]*AST{cell}), x)))
+}
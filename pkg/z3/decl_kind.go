@@ -0,0 +1,219 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// DeclKind is the kind of built-in operator of a function declaration, e.g., the operator of an application.
+type DeclKind int
+
+// The kinds of the most common built-in operators. Function declarations of the user have the kind DeclKindUninterpreted.
+// Further kinds, e.g., of proof rules or floating-point operations, are represented by the value of the corresponding Z3_decl_kind.
+const (
+	// Basic
+	DeclKindTrue     = DeclKind(C.Z3_OP_TRUE)     // The constant true.
+	DeclKindFalse    = DeclKind(C.Z3_OP_FALSE)    // The constant false.
+	DeclKindEq       = DeclKind(C.Z3_OP_EQ)       // The equality predicate.
+	DeclKindDistinct = DeclKind(C.Z3_OP_DISTINCT) // The n-ary distinct predicate (every argument is mutually distinct).
+	DeclKindIte      = DeclKind(C.Z3_OP_ITE)      // The ternary if-then-else term.
+	DeclKindAnd      = DeclKind(C.Z3_OP_AND)      // n-ary conjunction.
+	DeclKindOr       = DeclKind(C.Z3_OP_OR)       // n-ary disjunction.
+	DeclKindIff      = DeclKind(C.Z3_OP_IFF)      // Equivalence (binary).
+	DeclKindXor      = DeclKind(C.Z3_OP_XOR)      // Exclusive or.
+	DeclKindNot      = DeclKind(C.Z3_OP_NOT)      // Negation.
+	DeclKindImplies  = DeclKind(C.Z3_OP_IMPLIES)  // Implication.
+	DeclKindOEq      = DeclKind(C.Z3_OP_OEQ)      // Binary equivalence modulo namings.
+
+	// Arithmetic
+	DeclKindArithmeticNumeral = DeclKind(C.Z3_OP_ANUM)    // Arithmetic numeral.
+	DeclKindAlgebraicNumeral  = DeclKind(C.Z3_OP_AGNUM)   // Arithmetic algebraic numeral.
+	DeclKindLE                = DeclKind(C.Z3_OP_LE)      // <=.
+	DeclKindGE                = DeclKind(C.Z3_OP_GE)      // >=.
+	DeclKindLT                = DeclKind(C.Z3_OP_LT)      // <.
+	DeclKindGT                = DeclKind(C.Z3_OP_GT)      // >.
+	DeclKindAdd               = DeclKind(C.Z3_OP_ADD)     // Addition - Binary.
+	DeclKindSubtract          = DeclKind(C.Z3_OP_SUB)     // Binary subtraction.
+	DeclKindMinus             = DeclKind(C.Z3_OP_UMINUS)  // Unary minus.
+	DeclKindMultiply          = DeclKind(C.Z3_OP_MUL)     // Multiplication - Binary.
+	DeclKindDivide            = DeclKind(C.Z3_OP_DIV)     // Division - Binary.
+	DeclKindIntegerDivide     = DeclKind(C.Z3_OP_IDIV)    // Integer division - Binary.
+	DeclKindRemainder         = DeclKind(C.Z3_OP_REM)     // Remainder - Binary.
+	DeclKindModulus           = DeclKind(C.Z3_OP_MOD)     // Modulus - Binary.
+	DeclKindToReal            = DeclKind(C.Z3_OP_TO_REAL) // Coercion of integer to real - Unary.
+	DeclKindToInt             = DeclKind(C.Z3_OP_TO_INT)  // Coercion of real to integer - Unary.
+	DeclKindIsInt             = DeclKind(C.Z3_OP_IS_INT)  // Check if real is also an integer - Unary.
+	DeclKindPower             = DeclKind(C.Z3_OP_POWER)   // Power operator x^y.
+
+	// Arrays
+	DeclKindStore        = DeclKind(C.Z3_OP_STORE)         // Array store.
+	DeclKindSelect       = DeclKind(C.Z3_OP_SELECT)        // Array select.
+	DeclKindConstArray   = DeclKind(C.Z3_OP_CONST_ARRAY)   // The constant array.
+	DeclKindArrayMap     = DeclKind(C.Z3_OP_ARRAY_MAP)     // Array map operator.
+	DeclKindArrayDefault = DeclKind(C.Z3_OP_ARRAY_DEFAULT) // Default value of arrays.
+	DeclKindArrayExt     = DeclKind(C.Z3_OP_ARRAY_EXT)     // Array extensionality function.
+	DeclKindAsArray      = DeclKind(C.Z3_OP_AS_ARRAY)      // An array value that behaves as the function graph of a function.
+
+	// Bit-vectors
+	DeclKindBVNumeral              = DeclKind(C.Z3_OP_BNUM)             // Bit-vector numeral.
+	DeclKindBVNegate               = DeclKind(C.Z3_OP_BNEG)             // Unary minus.
+	DeclKindBVAdd                  = DeclKind(C.Z3_OP_BADD)             // Binary addition.
+	DeclKindBVSubtract             = DeclKind(C.Z3_OP_BSUB)             // Binary subtraction.
+	DeclKindBVMultiply             = DeclKind(C.Z3_OP_BMUL)             // Binary multiplication.
+	DeclKindBVSignedDivide         = DeclKind(C.Z3_OP_BSDIV)            // Binary signed division.
+	DeclKindBVUnsignedDivide       = DeclKind(C.Z3_OP_BUDIV)            // Binary unsigned division.
+	DeclKindBVSignedRemainder      = DeclKind(C.Z3_OP_BSREM)            // Binary signed remainder.
+	DeclKindBVUnsignedRemainder    = DeclKind(C.Z3_OP_BUREM)            // Binary unsigned remainder.
+	DeclKindBVSignedModulus        = DeclKind(C.Z3_OP_BSMOD)            // Binary signed modulus.
+	DeclKindBVULE                  = DeclKind(C.Z3_OP_ULEQ)             // Unsigned bit-vector <= - Binary relation.
+	DeclKindBVSLE                  = DeclKind(C.Z3_OP_SLEQ)             // Signed bit-vector <= - Binary relation.
+	DeclKindBVUGE                  = DeclKind(C.Z3_OP_UGEQ)             // Unsigned bit-vector >= - Binary relation.
+	DeclKindBVSGE                  = DeclKind(C.Z3_OP_SGEQ)             // Signed bit-vector >= - Binary relation.
+	DeclKindBVULT                  = DeclKind(C.Z3_OP_ULT)              // Unsigned bit-vector < - Binary relation.
+	DeclKindBVSLT                  = DeclKind(C.Z3_OP_SLT)              // Signed bit-vector < - Binary relation.
+	DeclKindBVUGT                  = DeclKind(C.Z3_OP_UGT)              // Unsigned bit-vector > - Binary relation.
+	DeclKindBVSGT                  = DeclKind(C.Z3_OP_SGT)              // Signed bit-vector > - Binary relation.
+	DeclKindBVAnd                  = DeclKind(C.Z3_OP_BAND)             // Bit-wise and - Binary.
+	DeclKindBVOr                   = DeclKind(C.Z3_OP_BOR)              // Bit-wise or - Binary.
+	DeclKindBVNot                  = DeclKind(C.Z3_OP_BNOT)             // Bit-wise not - Unary.
+	DeclKindBVXor                  = DeclKind(C.Z3_OP_BXOR)             // Bit-wise xor - Binary.
+	DeclKindBVNand                 = DeclKind(C.Z3_OP_BNAND)            // Bit-wise nand - Binary.
+	DeclKindBVNor                  = DeclKind(C.Z3_OP_BNOR)             // Bit-wise nor - Binary.
+	DeclKindBVXnor                 = DeclKind(C.Z3_OP_BXNOR)            // Bit-wise xnor - Binary.
+	DeclKindConcat                 = DeclKind(C.Z3_OP_CONCAT)           // Bit-vector concatenation - Binary.
+	DeclKindSignExtend             = DeclKind(C.Z3_OP_SIGN_EXT)         // Bit-vector sign extension.
+	DeclKindZeroExtend             = DeclKind(C.Z3_OP_ZERO_EXT)         // Bit-vector zero extension.
+	DeclKindExtract                = DeclKind(C.Z3_OP_EXTRACT)          // Bit-vector extraction.
+	DeclKindRepeat                 = DeclKind(C.Z3_OP_REPEAT)           // Repeat bit-vector n times.
+	DeclKindBVRedOr                = DeclKind(C.Z3_OP_BREDOR)           // Bit-vector reduce or - Unary.
+	DeclKindBVRedAnd               = DeclKind(C.Z3_OP_BREDAND)          // Bit-vector reduce and - Unary.
+	DeclKindBVShiftLeft            = DeclKind(C.Z3_OP_BSHL)             // Shift left.
+	DeclKindBVLogicalShiftRight    = DeclKind(C.Z3_OP_BLSHR)            // Logical shift right.
+	DeclKindBVArithmeticShiftRight = DeclKind(C.Z3_OP_BASHR)            // Arithmetical shift right.
+	DeclKindRotateLeft             = DeclKind(C.Z3_OP_ROTATE_LEFT)      // Left rotation.
+	DeclKindRotateRight            = DeclKind(C.Z3_OP_ROTATE_RIGHT)     // Right rotation.
+	DeclKindBVRotateLeft           = DeclKind(C.Z3_OP_EXT_ROTATE_LEFT)  // Left rotation by a bit-vector amount.
+	DeclKindBVRotateRight          = DeclKind(C.Z3_OP_EXT_ROTATE_RIGHT) // Right rotation by a bit-vector amount.
+	DeclKindIntToBV                = DeclKind(C.Z3_OP_INT2BV)           // Coerce integer to bit-vector.
+	DeclKindBVToInt                = DeclKind(C.Z3_OP_BV2INT)           // Coerce bit-vector to integer.
+
+	// Datatypes
+	DeclKindDatatypeConstructor = DeclKind(C.Z3_OP_DT_CONSTRUCTOR) // Datatype constructor.
+	DeclKindDatatypeRecognizer  = DeclKind(C.Z3_OP_DT_RECOGNISER)  // Datatype recognizer.
+	DeclKindDatatypeIs          = DeclKind(C.Z3_OP_DT_IS)          // Datatype recognizer (is).
+	DeclKindDatatypeAccessor    = DeclKind(C.Z3_OP_DT_ACCESSOR)    // Datatype accessor.
+
+	// Pseudo-Booleans
+	DeclKindPbAtMost  = DeclKind(C.Z3_OP_PB_AT_MOST)  // Cardinality constraint, e.g., x + y + z <= 2.
+	DeclKindPbAtLeast = DeclKind(C.Z3_OP_PB_AT_LEAST) // Cardinality constraint, e.g., x + y + z >= 2.
+	DeclKindPbLE      = DeclKind(C.Z3_OP_PB_LE)       // Generalized Pseudo-Boolean cardinality constraint, e.g., 2*x + 3*y <= 4.
+	DeclKindPbGE      = DeclKind(C.Z3_OP_PB_GE)       // Generalized Pseudo-Boolean cardinality constraint, e.g., 2*x + 3*y + 2*z >= 4.
+	DeclKindPbEq      = DeclKind(C.Z3_OP_PB_EQ)       // Generalized Pseudo-Boolean equality constraint, e.g., 2*x + 1*y + 2*z + 1*u = 4.
+
+	// Other
+	DeclKindLabel         = DeclKind(C.Z3_OP_LABEL)         // A label (used by the Boogie Verification condition generator).
+	DeclKindLabelLiteral  = DeclKind(C.Z3_OP_LABEL_LIT)     // A label literal (used by the Boogie Verification condition generator).
+	DeclKindUninterpreted = DeclKind(C.Z3_OP_UNINTERPRETED) // Kind used for uninterpreted symbols.
+)
+
+var declKindNames = map[DeclKind]string{
+	DeclKindTrue:                   "true",
+	DeclKindFalse:                  "false",
+	DeclKindEq:                     "=",
+	DeclKindDistinct:               "distinct",
+	DeclKindIte:                    "ite",
+	DeclKindAnd:                    "and",
+	DeclKindOr:                     "or",
+	DeclKindIff:                    "iff",
+	DeclKindXor:                    "xor",
+	DeclKindNot:                    "not",
+	DeclKindImplies:                "=>",
+	DeclKindOEq:                    "~",
+	DeclKindArithmeticNumeral:      "anum",
+	DeclKindAlgebraicNumeral:       "agnum",
+	DeclKindLE:                     "<=",
+	DeclKindGE:                     ">=",
+	DeclKindLT:                     "<",
+	DeclKindGT:                     ">",
+	DeclKindAdd:                    "+",
+	DeclKindSubtract:               "-",
+	DeclKindMinus:                  "uminus",
+	DeclKindMultiply:               "*",
+	DeclKindDivide:                 "/",
+	DeclKindIntegerDivide:          "div",
+	DeclKindRemainder:              "rem",
+	DeclKindModulus:                "mod",
+	DeclKindToReal:                 "to_real",
+	DeclKindToInt:                  "to_int",
+	DeclKindIsInt:                  "is_int",
+	DeclKindPower:                  "^",
+	DeclKindStore:                  "store",
+	DeclKindSelect:                 "select",
+	DeclKindConstArray:             "const",
+	DeclKindArrayMap:               "map",
+	DeclKindArrayDefault:           "default",
+	DeclKindArrayExt:               "array-ext",
+	DeclKindAsArray:                "as-array",
+	DeclKindBVNumeral:              "bv",
+	DeclKindBVNegate:               "bvneg",
+	DeclKindBVAdd:                  "bvadd",
+	DeclKindBVSubtract:             "bvsub",
+	DeclKindBVMultiply:             "bvmul",
+	DeclKindBVSignedDivide:         "bvsdiv",
+	DeclKindBVUnsignedDivide:       "bvudiv",
+	DeclKindBVSignedRemainder:      "bvsrem",
+	DeclKindBVUnsignedRemainder:    "bvurem",
+	DeclKindBVSignedModulus:        "bvsmod",
+	DeclKindBVULE:                  "bvule",
+	DeclKindBVSLE:                  "bvsle",
+	DeclKindBVUGE:                  "bvuge",
+	DeclKindBVSGE:                  "bvsge",
+	DeclKindBVULT:                  "bvult",
+	DeclKindBVSLT:                  "bvslt",
+	DeclKindBVUGT:                  "bvugt",
+	DeclKindBVSGT:                  "bvsgt",
+	DeclKindBVAnd:                  "bvand",
+	DeclKindBVOr:                   "bvor",
+	DeclKindBVNot:                  "bvnot",
+	DeclKindBVXor:                  "bvxor",
+	DeclKindBVNand:                 "bvnand",
+	DeclKindBVNor:                  "bvnor",
+	DeclKindBVXnor:                 "bvxnor",
+	DeclKindConcat:                 "concat",
+	DeclKindSignExtend:             "sign_extend",
+	DeclKindZeroExtend:             "zero_extend",
+	DeclKindExtract:                "extract",
+	DeclKindRepeat:                 "repeat",
+	DeclKindBVRedOr:                "bvredor",
+	DeclKindBVRedAnd:               "bvredand",
+	DeclKindBVShiftLeft:            "bvshl",
+	DeclKindBVLogicalShiftRight:    "bvlshr",
+	DeclKindBVArithmeticShiftRight: "bvashr",
+	DeclKindRotateLeft:             "rotate_left",
+	DeclKindRotateRight:            "rotate_right",
+	DeclKindBVRotateLeft:           "ext_rotate_left",
+	DeclKindBVRotateRight:          "ext_rotate_right",
+	DeclKindIntToBV:                "int2bv",
+	DeclKindBVToInt:                "bv2int",
+	DeclKindDatatypeConstructor:    "constructor",
+	DeclKindDatatypeRecognizer:     "recognizer",
+	DeclKindDatatypeIs:             "is",
+	DeclKindDatatypeAccessor:       "accessor",
+	DeclKindPbAtMost:               "at-most",
+	DeclKindPbAtLeast:              "at-least",
+	DeclKindPbLE:                   "pble",
+	DeclKindPbGE:                   "pbge",
+	DeclKindPbEq:                   "pbeq",
+	DeclKindLabel:                  "label",
+	DeclKindLabelLiteral:           "label-lit",
+	DeclKindUninterpreted:          "uninterpreted",
+}
+
+func (kind DeclKind) String() string {
+	if name, ok := declKindNames[kind]; ok {
+		return name
+	}
+	return "unknown"
+}
@@ -0,0 +1,114 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// ErrorCode is the kind of error reported by Z3.
+type ErrorCode int
+
+// The different kinds of Z3 errors.
+const (
+	ErrorCodeOK               = ErrorCode(C.Z3_OK)                // No error
+	ErrorCodeSortError        = ErrorCode(C.Z3_SORT_ERROR)        // User tried to build an invalid (type incorrect) AST
+	ErrorCodeIndexOutOfBounds = ErrorCode(C.Z3_IOB)               // Index out of bounds
+	ErrorCodeInvalidArgument  = ErrorCode(C.Z3_INVALID_ARG)       // Invalid argument was provided
+	ErrorCodeParserError      = ErrorCode(C.Z3_PARSER_ERROR)      // An error occurred when parsing a string or file
+	ErrorCodeNoParser         = ErrorCode(C.Z3_NO_PARSER)         // Parser output is not available
+	ErrorCodeInvalidPattern   = ErrorCode(C.Z3_INVALID_PATTERN)   // Invalid pattern was used to build a quantifier
+	ErrorCodeMemoryOut        = ErrorCode(C.Z3_MEMOUT_FAIL)       // A memory allocation failure was encountered
+	ErrorCodeFileAccessError  = ErrorCode(C.Z3_FILE_ACCESS_ERROR) // A file could not be accessed
+	ErrorCodeInternalFatal    = ErrorCode(C.Z3_INTERNAL_FATAL)    // An error internal to Z3 occurred
+	ErrorCodeInvalidUsage     = ErrorCode(C.Z3_INVALID_USAGE)     // API call is invalid in the current state
+	ErrorCodeDecRefError      = ErrorCode(C.Z3_DEC_REF_ERROR)     // Trying to decrement the reference counter of an AST that was deleted
+	ErrorCodeException        = ErrorCode(C.Z3_EXCEPTION)         // Internal Z3 exception
+)
+
+func (code ErrorCode) String() string {
+	switch code {
+	case ErrorCodeOK:
+		return "ok"
+	case ErrorCodeSortError:
+		return "sort error"
+	case ErrorCodeIndexOutOfBounds:
+		return "index out of bounds"
+	case ErrorCodeInvalidArgument:
+		return "invalid argument"
+	case ErrorCodeParserError:
+		return "parser error"
+	case ErrorCodeNoParser:
+		return "parser output is not available"
+	case ErrorCodeInvalidPattern:
+		return "invalid pattern"
+	case ErrorCodeMemoryOut:
+		return "out of memory"
+	case ErrorCodeFileAccessError:
+		return "file access error"
+	case ErrorCodeInternalFatal:
+		return "internal error"
+	case ErrorCodeInvalidUsage:
+		return "invalid usage"
+	case ErrorCodeDecRefError:
+		return "invalid reference count decrement"
+	}
+	return "exception"
+}
+
+// Error reported by Z3 for an operation.
+type Error struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (err *Error) Error() string {
+	if err.Message == "" || err.Message == err.Code.String() {
+		return "z3: " + err.Code.String()
+	}
+	return "z3: " + err.Code.String() + ": " + err.Message
+}
+
+// Panic with an *Error if the last operation of the context has an error status.
+//
+// Z3 resets the error status at the start of most operations. Hence, this has to be
+// invoked right after the operation and before using its result in another operation.
+func (context *Context) check() {
+	code := C.Z3_get_error_code(context.z3Context)
+	if code == C.Z3_OK {
+		return
+	}
+
+	err := &Error{
+		Code:    ErrorCode(code),
+		Message: C.GoString(C.Z3_get_error_msg(context.z3Context, code)),
+	}
+
+	// Some operations, e.g., Z3_eval_smtlib2_string, do not reset the error status.
+	// Clear it, so the error is not reported again by the next operation.
+	C.Z3_set_error(context.z3Context, C.Z3_OK)
+	panic(err)
+}
+
+// Perform the operation and return the error reported by Z3 instead of panicking.
+//
+// Operations such as Add or Eq panic with an *Error if Z3 rejects them, for example,
+// because the operands are ill-sorted:
+//
+//	sum, err := z3.Try(func() *z3.AST { return z3.Add(x, y) })
+//
+// Panics that are not caused by Z3 errors are propagated.
+func Try[T any](operation func() T) (value T, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			z3Error, ok := recovered.(*Error)
+			if !ok {
+				panic(recovered)
+			}
+			err = z3Error
+		}
+	}()
+
+	return operation(), nil
+}
@@ -0,0 +1,129 @@
+package z3
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrySortError(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.IntegerSort())
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+
+	// Act
+	selected, err := Try(func() *AST {
+		return Select(x, p)
+	})
+
+	// Assert
+	var z3Error *Error
+	assert.Nil(t, selected)
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeSortError, z3Error.Code)
+	assert.Contains(t, err.Error(), "z3: sort error")
+}
+
+func TestTryWithoutError(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.IntegerSort())
+
+	// Act
+	sum, err := TryAdd(x, x)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "(+ x x)", sum.String())
+}
+
+func TestTryAddIllSorted(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.IntegerSort())
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+
+	// Act
+	sum, err := TryAdd(x, p)
+
+	// Assert
+	var z3Error *Error
+	assert.Nil(t, sum)
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Panics(t, func() { Add(x, p) })
+}
+
+func TestParseError(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	asts, err := context.Parse("(assert (> x 0))")
+	_, incomplete := context.Parse("(assert")
+
+	// Assert
+	var z3Error *Error
+	assert.Nil(t, asts)
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeParserError, z3Error.Code)
+	assert.Error(t, incomplete)
+}
+
+func TestContextUsableAfterError(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	x := context.NewConstant(WithName("x"), context.IntegerSort())
+	_, err := Try(func() *AST {
+		return And(x, x)
+	})
+
+	// Act
+	solver.Assert(Eq(x, context.NewInt(1, context.IntegerSort())))
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, solver.Check().IsTrue())
+}
+
+func TestErrorsOfConcurrentOperations(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+	var group sync.WaitGroup
+	failures := make([]error, 1000)
+
+	// Act
+	group.Add(2)
+	go func() {
+		defer group.Done()
+		for idx := range failures {
+			_, failures[idx] = TryAdd(x, p)
+		}
+	}()
+	go func() {
+		defer group.Done()
+		for range failures {
+			integer.AST()
+			integer.Kind()
+			context.NewTrue()
+		}
+	}()
+	group.Wait()
+
+	// Assert
+	for _, err := range failures {
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,284 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+// Context for the recursive predicate (Datalog and constrained Horn clause) engines of Z3.
+//
+// Relations are function declarations with a Boolean range. They are defined by rules, i.e.,
+// universally quantified implications such as (forall (x y) (=> (edge x y) (path x y))), and by facts.
+// Queries ask whether a formula over the relations is derivable.
+type Fixedpoint struct {
+	context      *Context
+	z3Fixedpoint C.Z3_fixedpoint
+}
+
+// The engines to use for fixedpoint queries, see SetEngine.
+const (
+	FixedpointEngineDatalog = "datalog" // Bottom-up evaluation for relations over finite domains
+	FixedpointEngineSpacer  = "spacer"  // Property directed reachability for constrained Horn clauses
+)
+
+// Create a new fixedpoint context.
+func (context *Context) NewFixedpoint() *Fixedpoint {
+	return compute(context, func() *Fixedpoint {
+		return context.wrapFixedpoint(
+			C.Z3_mk_fixedpoint(context.z3Context),
+		)
+	})
+}
+
+func (context *Context) wrapFixedpoint(z3Fixedpoint C.Z3_fixedpoint) *Fixedpoint {
+	context.check()
+
+	fixedpoint := &Fixedpoint{
+		context:      context,
+		z3Fixedpoint: z3Fixedpoint,
+	}
+
+	C.Z3_fixedpoint_inc_ref(context.z3Context, z3Fixedpoint)
+	runtime.SetFinalizer(fixedpoint, func(fixedpoint *Fixedpoint) {
+		context.release(func() {
+			C.Z3_fixedpoint_dec_ref(context.z3Context, fixedpoint.z3Fixedpoint)
+		})
+	})
+
+	return fixedpoint
+}
+
+func (fixedpoint *Fixedpoint) Context() *Context {
+	return fixedpoint.context
+}
+
+// Return a string describing all available parameters of the fixedpoint context.
+func (fixedpoint *Fixedpoint) Help() string {
+	return compute(fixedpoint.context, func() string {
+		return C.GoString(C.Z3_fixedpoint_get_help(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint))
+	}, fixedpoint)
+}
+
+// Select the engine used to answer queries, e.g., FixedpointEngineDatalog or FixedpointEngineSpacer.
+// By default, Z3 selects the engine based on the sorts of the relations.
+func (fixedpoint *Fixedpoint) SetEngine(engine string) {
+	params := fixedpoint.context.NewParams()
+	params.SetSymbol("engine", engine)
+	fixedpoint.SetParams(params)
+}
+
+// Register the relation as a recursive predicate.
+// Relations have to be registered before they occur in the head of a rule or in a query.
+func (fixedpoint *Fixedpoint) RegisterRelation(relation *FunctionDeclaration) {
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_register_relation(
+			fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, relation.z3FunctionDeclaration,
+		)
+	}, fixedpoint, relation)
+}
+
+// Add a rule to the fixedpoint context. The rule is a Horn clause, i.e., of the form
+// (forall (bound) (=> (and body) head)), where head is an application of a relation.
+// The name is optional and may be nil.
+func (fixedpoint *Fixedpoint) AddRule(rule *AST, name SymbolFactory) {
+	z3Name := fixedpoint.ruleName(name)
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_add_rule(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, rule.z3AST, z3Name)
+	}, fixedpoint, rule)
+}
+
+// Update the rule with the given name, or add the rule if no rule with the name exists.
+func (fixedpoint *Fixedpoint) UpdateRule(rule *AST, name SymbolFactory) {
+	z3Name := fixedpoint.ruleName(name)
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_update_rule(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, rule.z3AST, z3Name)
+	}, fixedpoint, rule)
+}
+
+func (fixedpoint *Fixedpoint) ruleName(name SymbolFactory) C.Z3_symbol {
+	if name == nil {
+		return nil
+	}
+	return name(fixedpoint.context).z3Symbol
+}
+
+// Add a fact to a relation over finite domains, e.g., bit-vectors or finite domain sorts.
+// The arguments are the values of the elements of the domains, one per parameter of the relation.
+func (fixedpoint *Fixedpoint) AddFact(relation *FunctionDeclaration, args ...uint) {
+	z3Args := make([]C.uint, len(args))
+	for idx := range args {
+		z3Args[idx] = C.uint(args[idx])
+	}
+
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_add_fact(
+			fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, relation.z3FunctionDeclaration,
+			C.uint(len(z3Args)), pointerTo(z3Args),
+		)
+	}, fixedpoint, relation)
+}
+
+// Assert a constraint (without relations) into the fixedpoint context.
+// The constraints are used as background axioms.
+func (fixedpoint *Fixedpoint) Assert(axiom *AST) {
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_assert(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, axiom.z3AST)
+	}, fixedpoint, axiom)
+}
+
+// Pose a query against the asserted rules. The query is a formula over the relations whose variables
+// are bound by an existential quantifier, and it is true if an instance of the query is derivable from the rules.
+//
+// If the result is true, Answer returns a derivation of the query.
+// If the result is false, Answer returns an (inductive) invariant of the relations that excludes the query.
+func (fixedpoint *Fixedpoint) Query(query *AST) LiftedBoolean {
+	return compute(fixedpoint.context, func() LiftedBoolean {
+		return LiftedBoolean(
+			C.Z3_fixedpoint_query(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, query.z3AST),
+		)
+	}, fixedpoint, query)
+}
+
+// Pose a query whether any of the given relations contains a tuple.
+func (fixedpoint *Fixedpoint) QueryRelations(relations ...*FunctionDeclaration) LiftedBoolean {
+	z3Relations := make([]C.Z3_func_decl, len(relations))
+	for idx := range relations {
+		z3Relations[idx] = relations[idx].z3FunctionDeclaration
+	}
+
+	return compute(fixedpoint.context, func() LiftedBoolean {
+		return LiftedBoolean(
+			C.Z3_fixedpoint_query_relations(
+				fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint,
+				C.uint(len(z3Relations)), pointerTo(z3Relations),
+			),
+		)
+	}, fixedpoint, relations)
+}
+
+// Retrieve a formula that encodes the satisfying answers to the last query.
+//
+// When the engine is Datalog, the answer is a formula over the bound variables of the query describing
+// all derivable instances. When the engine is spacer, the answer is a proof of the query if it was
+// derivable, and an inductive invariant of the relations otherwise.
+func (fixedpoint *Fixedpoint) Answer() *AST {
+	return compute(fixedpoint.context, func() *AST {
+		return fixedpoint.context.wrapAST(
+			C.Z3_fixedpoint_get_answer(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint),
+		)
+	}, fixedpoint)
+}
+
+// Retrieve a string that describes the last status returned by Query.
+// Use this method when Query returns undefined.
+func (fixedpoint *Fixedpoint) ReasonUnknown() string {
+	return compute(fixedpoint.context, func() string {
+		return C.GoString(
+			C.Z3_fixedpoint_get_reason_unknown(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint),
+		)
+	}, fixedpoint)
+}
+
+// Return the number of levels explored for the relation by the spacer engine.
+func (fixedpoint *Fixedpoint) NumLevels(predicate *FunctionDeclaration) uint {
+	return compute(fixedpoint.context, func() uint {
+		return uint(C.Z3_fixedpoint_get_num_levels(
+			fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, predicate.z3FunctionDeclaration,
+		))
+	}, fixedpoint, predicate)
+}
+
+// Retrieve the properties of the relation found at the given level by the spacer engine.
+// The properties are a formula over the bound variables of the relation, i.e., (:var 0) refers
+// to the last parameter. The level -1 retrieves the properties that hold at all levels,
+// i.e., the inductive invariant of the relation.
+func (fixedpoint *Fixedpoint) Cover(level int, predicate *FunctionDeclaration) *AST {
+	return compute(fixedpoint.context, func() *AST {
+		return fixedpoint.context.wrapAST(
+			C.Z3_fixedpoint_get_cover_delta(
+				fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, C.int(level), predicate.z3FunctionDeclaration,
+			),
+		)
+	}, fixedpoint, predicate)
+}
+
+// Add a property of the relation at the given level to the spacer engine, see Cover.
+func (fixedpoint *Fixedpoint) AddCover(level int, predicate *FunctionDeclaration, property *AST) {
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_add_cover(
+			fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint,
+			C.int(level), predicate.z3FunctionDeclaration, property.z3AST,
+		)
+	}, fixedpoint, predicate, property)
+}
+
+// Return the rules of the fixedpoint context.
+func (fixedpoint *Fixedpoint) Rules() []*AST {
+	return compute(fixedpoint.context, func() []*AST {
+		return fixedpoint.context.wrapASTs(
+			C.Z3_fixedpoint_get_rules(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint),
+		)
+	}, fixedpoint)
+}
+
+// Return the background axioms of the fixedpoint context, see Assert.
+func (fixedpoint *Fixedpoint) Assertions() []*AST {
+	return compute(fixedpoint.context, func() []*AST {
+		return fixedpoint.context.wrapASTs(
+			C.Z3_fixedpoint_get_assertions(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint),
+		)
+	}, fixedpoint)
+}
+
+// Return statistics about the last query of the fixedpoint context.
+func (fixedpoint *Fixedpoint) Statistics() Statistics {
+	return compute(fixedpoint.context, func() Statistics {
+		return fixedpoint.context.wrapStatistics(
+			C.Z3_fixedpoint_get_statistics(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint),
+		)
+	}, fixedpoint)
+}
+
+// Parse an SMT-LIB2 string with fixedpoint rules, i.e., rule commands such as (rule (inv 0)).
+// The rules are added to the fixedpoint context, assertions are added as background axioms,
+// and the queries of the string are returned.
+func (fixedpoint *Fixedpoint) FromString(str string) []*AST {
+	// Allocate an unmanged string and make sure it is freed.
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+
+	return compute(fixedpoint.context, func() []*AST {
+		return fixedpoint.context.wrapASTs(
+			C.Z3_fixedpoint_from_string(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, cStr),
+		)
+	}, fixedpoint)
+}
+
+// Export the rules and background axioms of the fixedpoint context, followed by the given queries,
+// as an SMT-LIB2 string.
+func (fixedpoint *Fixedpoint) SMTLIB(queries ...*AST) string {
+	z3Queries := make([]C.Z3_ast, len(queries))
+	for idx := range queries {
+		z3Queries[idx] = queries[idx].z3AST
+	}
+
+	return compute(fixedpoint.context, func() string {
+		return C.GoString(
+			C.Z3_fixedpoint_to_string(
+				fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint,
+				C.uint(len(z3Queries)), pointerTo(z3Queries),
+			),
+		)
+	}, fixedpoint, queries)
+}
+
+func (fixedpoint *Fixedpoint) String() string {
+	return fixedpoint.SMTLIB()
+}
@@ -0,0 +1,127 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Define path as the transitive closure of the edges 1 -> 2 -> 3 over the integers.
+func newReachability(context *Context) (fixedpoint *Fixedpoint, path *FunctionDeclaration) {
+	integer := context.IntegerSort()
+	edge := context.NewFunctionDeclaration(WithName("edge"), []*Sort{integer, integer}, context.BooleanSort())
+	path = context.NewFunctionDeclaration(WithName("path"), []*Sort{integer, integer}, context.BooleanSort())
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	z := context.NewConstant(WithName("z"), integer)
+	one := context.NewInt(1, integer)
+	two := context.NewInt(2, integer)
+	three := context.NewInt(3, integer)
+
+	fixedpoint = context.NewFixedpoint()
+	fixedpoint.SetEngine(FixedpointEngineSpacer)
+	fixedpoint.RegisterRelation(edge)
+	fixedpoint.RegisterRelation(path)
+	fixedpoint.AddRule(edge.Application([]*AST{one, two}), WithName("first"))
+	fixedpoint.AddRule(edge.Application([]*AST{two, three}), nil)
+	fixedpoint.AddRule(ForAll([]*AST{x, y}, Implies(
+		edge.Application([]*AST{x, y}),
+		path.Application([]*AST{x, y}),
+	)), WithName("base"))
+	fixedpoint.AddRule(ForAll([]*AST{x, y, z}, Implies(
+		And(path.Application([]*AST{x, y}), edge.Application([]*AST{y, z})),
+		path.Application([]*AST{x, z}),
+	)), WithName("step"))
+	return fixedpoint, path
+}
+
+func TestFixedpointQueryDerivable(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	fixedpoint, path := newReachability(context)
+	integer := context.IntegerSort()
+
+	// Act
+	result := fixedpoint.Query(path.Application([]*AST{context.NewInt(1, integer), context.NewInt(3, integer)}))
+
+	// Assert
+	assert.True(t, result.IsTrue())
+	assert.NotNil(t, fixedpoint.Answer())
+}
+
+func TestFixedpointQueryInvariant(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	fixedpoint, path := newReachability(context)
+	integer := context.IntegerSort()
+
+	// Act
+	result := fixedpoint.Query(path.Application([]*AST{context.NewInt(3, integer), context.NewInt(1, integer)}))
+
+	// Assert
+	assert.True(t, result.IsFalse())
+	assert.Equal(t, KindBoolean, fixedpoint.Answer().Sort().Kind())
+	assert.Equal(t, KindBoolean, fixedpoint.Cover(-1, path).Sort().Kind())
+}
+
+func TestFixedpointDatalogFacts(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	node := context.BitVectorSort(4)
+	edge := context.NewFunctionDeclaration(WithName("edge"), []*Sort{node, node}, context.BooleanSort())
+	fixedpoint := context.NewFixedpoint()
+	fixedpoint.SetEngine(FixedpointEngineDatalog)
+	fixedpoint.RegisterRelation(edge)
+
+	// Act
+	fixedpoint.AddFact(edge, 1, 2)
+	fixedpoint.AddFact(edge, 2, 3)
+
+	// Assert
+	assert.True(t, fixedpoint.QueryRelations(edge).IsTrue())
+	x := context.NewConstant(WithName("x"), node)
+	assert.True(t, fixedpoint.Query(Exists([]*AST{x}, edge.Application([]*AST{x, context.NewInt(3, node)}))).IsTrue())
+	assert.Equal(t, "(= (:var 0) #x2)", fixedpoint.Answer().String())
+}
+
+func TestFixedpointRulesAndSMTLIB(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	fixedpoint, path := newReachability(context)
+	integer := context.IntegerSort()
+	query := path.Application([]*AST{context.NewInt(1, integer), context.NewInt(3, integer)})
+
+	// Act
+	smtlib := fixedpoint.SMTLIB(query)
+
+	// Assert
+	assert.Len(t, fixedpoint.Rules(), 4)
+	assert.Contains(t, smtlib, "(declare-rel path (Int Int))")
+	assert.Contains(t, smtlib, "(query")
+}
+
+func TestFixedpointFromString(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	fixedpoint := context.NewFixedpoint()
+	inv := context.NewFunctionDeclaration(WithName("inv"), []*Sort{context.IntegerSort()}, context.BooleanSort())
+	bad := context.NewFunctionDeclaration(WithName("bad"), []*Sort{}, context.BooleanSort())
+	fixedpoint.RegisterRelation(inv)
+	fixedpoint.RegisterRelation(bad)
+
+	// Act
+	queries := fixedpoint.FromString(`
+		(declare-fun inv (Int) Bool)
+		(declare-fun bad () Bool)
+		(rule (inv 0))
+		(rule (forall ((x Int)) (=> (and (inv x) (< x 10)) (inv (+ x 1)))))
+		(rule (forall ((x Int)) (=> (and (inv x) (> x 10)) bad)))
+	`)
+
+	// Assert
+	assert.Len(t, fixedpoint.Rules(), 3)
+	assert.Empty(t, queries)
+	assert.True(t, fixedpoint.Query(bad.Application([]*AST{})).IsFalse())
+	assert.NotEmpty(t, fixedpoint.Help())
+	assert.Contains(t, fixedpoint.ParamDescriptions().Names(), "engine")
+}
@@ -0,0 +1,531 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// Create the rounding mode sort.
+func (context *Context) RoundingModeSort() *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_fpa_rounding_mode_sort(context.z3Context),
+		)
+	})
+}
+
+// Create a floating-point sort with the given number of exponent and significand bits.
+// The number of significand bits includes the hidden bit.
+func (context *Context) FloatingPointSort(exponentBits, significandBits uint) *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_fpa_sort(context.z3Context, C.uint(exponentBits), C.uint(significandBits)),
+		)
+	})
+}
+
+// Create the half-precision (16-bit) floating-point sort.
+func (context *Context) Float16Sort() *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_fpa_sort_16(context.z3Context),
+		)
+	})
+}
+
+// Create the single-precision (32-bit) floating-point sort.
+func (context *Context) Float32Sort() *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_fpa_sort_32(context.z3Context),
+		)
+	})
+}
+
+// Create the double-precision (64-bit) floating-point sort.
+func (context *Context) Float64Sort() *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_fpa_sort_64(context.z3Context),
+		)
+	})
+}
+
+// Create the quadruple-precision (128-bit) floating-point sort.
+func (context *Context) Float128Sort() *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_fpa_sort_128(context.z3Context),
+		)
+	})
+}
+
+// Return the number of exponent bits of the given floating-point sort.
+func (sort *Sort) FloatingPointExponentBits() uint {
+	return compute(sort.context, func() uint {
+		return uint(C.Z3_fpa_get_ebits(sort.context.z3Context, sort.z3Sort))
+	}, sort)
+}
+
+// Return the number of significand bits of the given floating-point sort, including the hidden bit.
+func (sort *Sort) FloatingPointSignificandBits() uint {
+	return compute(sort.context, func() uint {
+		return uint(C.Z3_fpa_get_sbits(sort.context.z3Context, sort.z3Sort))
+	}, sort)
+}
+
+// Create the RoundNearestTiesToEven rounding mode.
+func (context *Context) RoundNearestTiesToEven() *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_round_nearest_ties_to_even(context.z3Context),
+		)
+	})
+}
+
+// Create the RoundNearestTiesToAway rounding mode.
+func (context *Context) RoundNearestTiesToAway() *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_round_nearest_ties_to_away(context.z3Context),
+		)
+	})
+}
+
+// Create the RoundTowardPositive rounding mode.
+func (context *Context) RoundTowardPositive() *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_round_toward_positive(context.z3Context),
+		)
+	})
+}
+
+// Create the RoundTowardNegative rounding mode.
+func (context *Context) RoundTowardNegative() *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_round_toward_negative(context.z3Context),
+		)
+	})
+}
+
+// Create the RoundTowardZero rounding mode.
+func (context *Context) RoundTowardZero() *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_round_toward_zero(context.z3Context),
+		)
+	})
+}
+
+// Create a floating-point NaN of the given sort.
+func (context *Context) NewFloatingPointNaN(sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_nan(context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a floating-point infinity of the given sort.
+// If negative is true, -oo will be generated instead of +oo.
+func (context *Context) NewFloatingPointInfinity(sort *Sort, negative bool) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_inf(context.z3Context, sort.z3Sort, C.bool(negative)),
+		)
+	}, sort)
+}
+
+// Create a floating-point zero of the given sort.
+// If negative is true, -zero will be generated instead of +zero.
+func (context *Context) NewFloatingPointZero(sort *Sort, negative bool) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_zero(context.z3Context, sort.z3Sort, C.bool(negative)),
+		)
+	}, sort)
+}
+
+// Create a numeral of the given floating-point sort from a float32.
+//
+// This function is used to create numerals that fit in a float value.
+// It is slightly faster than NewFloat64 since it is not necessary to parse a string.
+func (context *Context) NewFloat32(value float32, sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_numeral_float(context.z3Context, C.float(value), sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a numeral of the given floating-point sort from a float64.
+func (context *Context) NewFloat64(value float64, sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_fpa_numeral_double(context.z3Context, C.double(value), sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create an expression of the floating-point sort from three bit-vector expressions.
+//
+// This is the operator named `fp' in the SMT FP theory definition.
+// Note that sign is required to be a bit-vector of size 1. Significand and exponent
+// are required to be longer than 1 and 2 respectively. The floating-point sort
+// of the resulting expression is automatically determined from the bit-vector sizes
+// of the arguments. The exponent is assumed to be in IEEE-754 biased representation.
+func FP(sign, exponent, significand *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, sign, exponent, significand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_fp(context, sign, exponent, significand)
+		}, sign, exponent, significand,
+	)
+}
+
+// Floating-point absolute value.
+func FPAbs(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_abs(context, operand)
+		}, operand,
+	)
+}
+
+// Floating-point negation.
+func FPNegate(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_neg(context, operand)
+		}, operand,
+	)
+}
+
+// Floating-point addition.
+func FPAdd(roundingMode, lhs, rhs *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, roundingMode, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_add(context, roundingMode, lhs, rhs)
+		}, roundingMode, lhs, rhs,
+	)
+}
+
+// Floating-point subtraction.
+func FPSubtract(roundingMode, lhs, rhs *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, roundingMode, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_sub(context, roundingMode, lhs, rhs)
+		}, roundingMode, lhs, rhs,
+	)
+}
+
+// Floating-point multiplication.
+func FPMultiply(roundingMode, lhs, rhs *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, roundingMode, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_mul(context, roundingMode, lhs, rhs)
+		}, roundingMode, lhs, rhs,
+	)
+}
+
+// Floating-point division.
+func FPDivide(roundingMode, lhs, rhs *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, roundingMode, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_div(context, roundingMode, lhs, rhs)
+		}, roundingMode, lhs, rhs,
+	)
+}
+
+// Floating-point fused multiply-add.
+//
+// The result is round((a * b) + c) using the given rounding mode.
+func FPFusedMultiplyAdd(roundingMode, a, b, c *AST) *AST {
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_fma(context, operands[0], operands[1], operands[2], operands[3])
+		}, roundingMode, a, b, c,
+	)
+}
+
+// Floating-point square root.
+func FPSquareRoot(roundingMode, operand *AST) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_sqrt(context, roundingMode, operand)
+		}, roundingMode, operand,
+	)
+}
+
+// Floating-point remainder.
+func FPRemainder(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_rem(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Floating-point roundToIntegral. Rounds a floating-point number to
+// the closest integer, again represented as a floating-point number.
+func FPRoundToIntegral(roundingMode, operand *AST) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_round_to_integral(context, roundingMode, operand)
+		}, roundingMode, operand,
+	)
+}
+
+// Minimum of floating-point numbers.
+func FPMin(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_min(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Maximum of floating-point numbers.
+func FPMax(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_max(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Floating-point less than or equal.
+func FPLE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_leq(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Floating-point less than.
+func FPLT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_lt(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Floating-point greater than or equal.
+func FPGE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_geq(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Floating-point greater than.
+func FPGT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_gt(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Floating-point equality.
+//
+// Note that this is IEEE 754 equality (as opposed to SMT-LIB =).
+func FPEq(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_eq(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Predicate indicating whether operand is a normal floating-point number.
+func FPIsNormal(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_normal(context, operand)
+		}, operand,
+	)
+}
+
+// Predicate indicating whether operand is a subnormal floating-point number.
+func FPIsSubnormal(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_subnormal(context, operand)
+		}, operand,
+	)
+}
+
+// Predicate indicating whether operand is a floating-point number
+// with zero value, i.e., +zero or -zero.
+func FPIsZero(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_zero(context, operand)
+		}, operand,
+	)
+}
+
+// Predicate indicating whether operand is a floating-point number representing +oo or -oo.
+func FPIsInfinite(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_infinite(context, operand)
+		}, operand,
+	)
+}
+
+// Predicate indicating whether operand is a NaN.
+func FPIsNaN(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_nan(context, operand)
+		}, operand,
+	)
+}
+
+// Predicate indicating whether operand is a negative floating-point number.
+func FPIsNegative(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_negative(context, operand)
+		}, operand,
+	)
+}
+
+// Predicate indicating whether operand is a positive floating-point number.
+func FPIsPositive(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_is_positive(context, operand)
+		}, operand,
+	)
+}
+
+// Conversion of a single IEEE 754-2008 bit-vector into a floating-point number.
+//
+// Produces a term that represents the conversion of the bit-vector term operand to a
+// floating-point term of the given sort. The size of the bit-vector must be equal to
+// ebits+sbits of the sort. The format of the bit-vector is as defined by the IEEE 754-2008
+// interchange format.
+func BVToFP(operand *AST, sort *Sort) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_fp_bv(context, operand, sort.z3Sort)
+		}, operand,
+	)
+}
+
+// Conversion of a floating-point term into another floating-point term of different sort.
+//
+// If necessary, the result will be rounded according to the given rounding mode.
+func FPToFP(roundingMode, operand *AST, sort *Sort) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_fp_float(context, roundingMode, operand, sort.z3Sort)
+		}, roundingMode, operand,
+	)
+}
+
+// Conversion of a term of real sort into a term of floating-point sort.
+//
+// If necessary, the result will be rounded according to the given rounding mode.
+func RealToFP(roundingMode, operand *AST, sort *Sort) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_fp_real(context, roundingMode, operand, sort.z3Sort)
+		}, roundingMode, operand,
+	)
+}
+
+// Conversion of a term of integer sort into a term of floating-point sort.
+//
+// The integer is coerced into a real first. If necessary, the result will be rounded
+// according to the given rounding mode.
+func IntToFP(roundingMode, operand *AST, sort *Sort) *AST {
+	return RealToFP(roundingMode, IntToReal(operand), sort)
+}
+
+// Conversion of a 2's complement signed bit-vector term into a term of floating-point sort.
+//
+// If necessary, the result will be rounded according to the given rounding mode.
+func SignedBVToFP(roundingMode, operand *AST, sort *Sort) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_fp_signed(context, roundingMode, operand, sort.z3Sort)
+		}, roundingMode, operand,
+	)
+}
+
+// Conversion of an unsigned bit-vector term into a term of floating-point sort.
+//
+// If necessary, the result will be rounded according to the given rounding mode.
+func UnsignedBVToFP(roundingMode, operand *AST, sort *Sort) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_fp_unsigned(context, roundingMode, operand, sort.z3Sort)
+		}, roundingMode, operand,
+	)
+}
+
+// Conversion of a floating-point term into an unsigned bit-vector of the given width.
+//
+// If necessary, the result will be rounded according to the given rounding mode.
+// The result is unspecified for NaN, infinities and out of range values.
+func FPToUnsignedBV(roundingMode, operand *AST, width uint) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_ubv(context, roundingMode, operand, C.uint(width))
+		}, roundingMode, operand,
+	)
+}
+
+// Conversion of a floating-point term into a signed bit-vector of the given width.
+//
+// If necessary, the result will be rounded according to the given rounding mode.
+// The result is unspecified for NaN, infinities and out of range values.
+func FPToSignedBV(roundingMode, operand *AST, width uint) *AST {
+	return binary(
+		func(context C.Z3_context, roundingMode, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_sbv(context, roundingMode, operand, C.uint(width))
+		}, roundingMode, operand,
+	)
+}
+
+// Conversion of a floating-point term into a real-numbered term.
+//
+// The result is unspecified for NaN and infinities.
+func FPToReal(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_real(context, operand)
+		}, operand,
+	)
+}
+
+// Conversion of a floating-point term into an integer term.
+//
+// The floating-point term is converted to a real first which is then rounded
+// toward negative infinity. The result is unspecified for NaN and infinities.
+func FPToInt(operand *AST) *AST {
+	return RealToInt(FPToReal(operand))
+}
+
+// Conversion of a floating-point term into a bit-vector term in IEEE 754-2008 format.
+//
+// The size of the resulting bit-vector is automatically determined. Note that
+// IEEE 754-2008 allows multiple different representations of NaN. This conversion
+// knows only one NaN and it will always produce the same bit-vector representation of that NaN.
+func FPToIEEEBV(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_fpa_to_ieee_bv(context, operand)
+		}, operand,
+	)
+}
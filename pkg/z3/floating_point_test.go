@@ -0,0 +1,83 @@
+package z3
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloatingPointSorts(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	sort := context.Float32Sort()
+
+	// Assert
+	assert.Equal(t, KindFloatingPoint, sort.Kind())
+	assert.Equal(t, KindRoundingMode, context.RoundingModeSort().Kind())
+	assert.Equal(t, uint(8), sort.FloatingPointExponentBits())
+	assert.Equal(t, uint(24), sort.FloatingPointSignificandBits())
+	assert.True(t, sort.SameAs(context.FloatingPointSort(8, 24)))
+}
+
+func TestFloatingPointNaN(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	sort := context.Float64Sort()
+	nan := context.NewFloat64(math.NaN(), sort)
+
+	// Act
+	reflexive := solver.Proven(FPEq(nan, nan))
+
+	// Assert
+	assert.False(t, reflexive)
+	assert.True(t, solver.Proven(FPIsNaN(context.NewFloatingPointNaN(sort))))
+	assert.True(t, solver.Proven(Eq(nan, context.NewFloatingPointNaN(sort))))
+}
+
+func TestFloatingPointAdditionIsNotAssociative(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	sort := context.Float32Sort()
+	rm := context.RoundNearestTiesToEven()
+	x := context.NewConstant(WithName("x"), sort)
+	y := context.NewConstant(WithName("y"), sort)
+	z := context.NewConstant(WithName("z"), sort)
+
+	// Act
+	associative := solver.Proven(
+		FPEq(FPAdd(rm, FPAdd(rm, x, y), z), FPAdd(rm, x, FPAdd(rm, y, z))),
+	)
+
+	// Assert
+	assert.False(t, associative)
+}
+
+func TestFloatingPointConversions(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	sort := context.Float32Sort()
+	rm := context.RoundTowardZero()
+	value := context.NewFloat32(2.5, sort)
+
+	// Act
+	integer := FPToInt(value)
+	bits := FPToIEEEBV(value)
+
+	// Assert
+	assert.True(t, solver.Proven(Eq(integer, context.NewInt(2, context.IntegerSort()))))
+	assert.True(t, solver.Proven(Eq(bits, context.NewBitVector(int64(math.Float32bits(2.5)), 32))))
+	assert.True(t, solver.Proven(Eq(FPToSignedBV(rm, value, 8), context.NewBitVector(2, 8))))
+	assert.True(t, solver.Proven(FPEq(IntToFP(rm, context.NewInt(3, context.IntegerSort()), sort), context.NewFloat32(3, sort))))
+	assert.True(t, solver.Proven(FPIsInfinite(context.NewFloatingPointInfinity(sort, true))))
+	assert.True(t, solver.Proven(FPIsNegative(context.NewFloatingPointZero(sort, true))))
+}
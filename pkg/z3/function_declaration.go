@@ -6,6 +6,7 @@ package z3
 #include "../../modules/z3/src/api/z3.h"
 */
 import "C"
+import "runtime"
 
 // Kind of AST used to represent function symbols.
 type FunctionDeclaration struct {
@@ -14,10 +15,22 @@ type FunctionDeclaration struct {
 }
 
 func (context *Context) wrapFunctionDeclaration(function C.Z3_func_decl) *FunctionDeclaration {
-	return &FunctionDeclaration{
+	context.check()
+
+	declaration := &FunctionDeclaration{
 		context:               context,
 		z3FunctionDeclaration: function,
 	}
+
+	// Function declarations are ASTs as well, keep them alive while referenced from Go.
+	C.Z3_inc_ref(context.z3Context, C.Z3_func_decl_to_ast(context.z3Context, function))
+	runtime.SetFinalizer(declaration, func(declaration *FunctionDeclaration) {
+		context.release(func() {
+			C.Z3_dec_ref(context.z3Context, C.Z3_func_decl_to_ast(context.z3Context, declaration.z3FunctionDeclaration))
+		}, declaration)
+	})
+
+	return declaration
 }
 
 func (function *FunctionDeclaration) Application(arguments []*AST) *AST {
@@ -32,7 +45,7 @@ func (function *FunctionDeclaration) Application(arguments []*AST) *AST {
 				function.context.z3Context,
 				function.z3FunctionDeclaration,
 				C.uint(len(arguments)),
-				&args[0],
+				pointerTo(args),
 			),
 		)
 	}, function, arguments)
@@ -48,3 +61,65 @@ func (function *FunctionDeclaration) AST() *AST {
 		)
 	}, function)
 }
+
+// Return the name of the function.
+func (function *FunctionDeclaration) Name() Symbol {
+	return compute(function.context, func() Symbol {
+		return Symbol{
+			context:  function.context,
+			z3Symbol: C.Z3_get_decl_name(function.context.z3Context, function.z3FunctionDeclaration),
+		}
+	}, function)
+}
+
+// Return the kind of built-in operator of the function, or DeclKindUninterpreted for functions declared by the user.
+func (function *FunctionDeclaration) Kind() DeclKind {
+	return compute(function.context, func() DeclKind {
+		return DeclKind(C.Z3_get_decl_kind(function.context.z3Context, function.z3FunctionDeclaration))
+	}, function)
+}
+
+// Return the number of parameters of the function.
+func (function *FunctionDeclaration) Arity() uint {
+	return compute(function.context, func() uint {
+		return uint(C.Z3_get_arity(function.context.z3Context, function.z3FunctionDeclaration))
+	}, function)
+}
+
+// Return the sort of the parameter at position idx of the function.
+func (function *FunctionDeclaration) Domain(idx uint) *Sort {
+	return compute(function.context, func() *Sort {
+		return function.context.wrapSort(
+			C.Z3_get_domain(function.context.z3Context, function.z3FunctionDeclaration, C.uint(idx)),
+		)
+	}, function)
+}
+
+// Return the sorts of all parameters of the function.
+func (function *FunctionDeclaration) Domains() []*Sort {
+	context := function.context
+	return compute(context, func() []*Sort {
+		domains := make([]*Sort, C.Z3_get_domain_size(context.z3Context, function.z3FunctionDeclaration))
+		for idx := range domains {
+			domains[idx] = context.wrapSort(
+				C.Z3_get_domain(context.z3Context, function.z3FunctionDeclaration, C.uint(idx)),
+			)
+		}
+		return domains
+	}, function)
+}
+
+// Return the sort of the result of the function.
+func (function *FunctionDeclaration) Range() *Sort {
+	return compute(function.context, func() *Sort {
+		return function.context.wrapSort(
+			C.Z3_get_range(function.context.z3Context, function.z3FunctionDeclaration),
+		)
+	}, function)
+}
+
+func (function *FunctionDeclaration) String() string {
+	return compute(function.context, func() string {
+		return C.GoString(C.Z3_func_decl_to_string(function.context.z3Context, function.z3FunctionDeclaration))
+	}, function)
+}
@@ -0,0 +1,177 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+import "runtime"
+
+// Interpretation of a function in a model. It is a finite list of entries, i.e., arguments and the value of the
+// function for them, and an else value for all other arguments.
+type FunctionInterpretation struct {
+	context                  *Context
+	z3FunctionInterpretation C.Z3_func_interp
+}
+
+func (context *Context) wrapFunctionInterpretation(z3FunctionInterpretation C.Z3_func_interp) *FunctionInterpretation {
+	context.check()
+
+	interpretation := &FunctionInterpretation{
+		context:                  context,
+		z3FunctionInterpretation: z3FunctionInterpretation,
+	}
+
+	C.Z3_func_interp_inc_ref(context.z3Context, z3FunctionInterpretation)
+	runtime.SetFinalizer(interpretation, func(interpretation *FunctionInterpretation) {
+		context.release(func() {
+			C.Z3_func_interp_dec_ref(context.z3Context, interpretation.z3FunctionInterpretation)
+		})
+	})
+
+	return interpretation
+}
+
+// Return the number of entries in the function interpretation.
+func (interpretation *FunctionInterpretation) NumEntries() uint {
+	return compute(interpretation.context, func() uint {
+		return uint(C.Z3_func_interp_get_num_entries(
+			interpretation.context.z3Context, interpretation.z3FunctionInterpretation,
+		))
+	}, interpretation)
+}
+
+// Return the entry at position idx of the function interpretation.
+func (interpretation *FunctionInterpretation) Entry(idx uint) *FunctionEntry {
+	return compute(interpretation.context, func() *FunctionEntry {
+		return interpretation.context.wrapFunctionEntry(
+			C.Z3_func_interp_get_entry(
+				interpretation.context.z3Context, interpretation.z3FunctionInterpretation, C.uint(idx),
+			),
+		)
+	}, interpretation)
+}
+
+// Return all entries of the function interpretation.
+func (interpretation *FunctionInterpretation) Entries() []*FunctionEntry {
+	context := interpretation.context
+	return compute(context, func() []*FunctionEntry {
+		entries := make([]*FunctionEntry, C.Z3_func_interp_get_num_entries(
+			context.z3Context, interpretation.z3FunctionInterpretation,
+		))
+		for idx := range entries {
+			entries[idx] = context.wrapFunctionEntry(
+				C.Z3_func_interp_get_entry(context.z3Context, interpretation.z3FunctionInterpretation, C.uint(idx)),
+			)
+		}
+		return entries
+	}, interpretation)
+}
+
+// Return the value of the function for all arguments that are not covered by an entry.
+func (interpretation *FunctionInterpretation) Else() *AST {
+	return compute(interpretation.context, func() *AST {
+		return interpretation.context.wrapAST(
+			C.Z3_func_interp_get_else(interpretation.context.z3Context, interpretation.z3FunctionInterpretation),
+		)
+	}, interpretation)
+}
+
+// Set the value of the function for all arguments that are not covered by an entry.
+func (interpretation *FunctionInterpretation) SetElse(value *AST) {
+	interpretation.context.do(func() {
+		C.Z3_func_interp_set_else(
+			interpretation.context.z3Context, interpretation.z3FunctionInterpretation, value.z3AST,
+		)
+	}, interpretation, value)
+}
+
+// Return the arity (number of arguments) of the function.
+func (interpretation *FunctionInterpretation) Arity() uint {
+	return compute(interpretation.context, func() uint {
+		return uint(C.Z3_func_interp_get_arity(
+			interpretation.context.z3Context, interpretation.z3FunctionInterpretation,
+		))
+	}, interpretation)
+}
+
+// Add an entry mapping the given arguments to the value.
+// The number of arguments has to match the arity of the function.
+// If an entry for the arguments exists already, its value is replaced.
+func (interpretation *FunctionInterpretation) AddEntry(arguments []*AST, value *AST) {
+	context := interpretation.context
+	context.do(func() {
+		vector := C.Z3_mk_ast_vector(context.z3Context)
+		C.Z3_ast_vector_inc_ref(context.z3Context, vector)
+		defer C.Z3_ast_vector_dec_ref(context.z3Context, vector)
+
+		for _, argument := range arguments {
+			C.Z3_ast_vector_push(context.z3Context, vector, argument.z3AST)
+			context.check()
+		}
+		C.Z3_func_interp_add_entry(context.z3Context, interpretation.z3FunctionInterpretation, vector, value.z3AST)
+	}, interpretation, arguments, value)
+}
+
+// Point of a function interpretation, i.e., a value of the function for particular arguments.
+type FunctionEntry struct {
+	context         *Context
+	z3FunctionEntry C.Z3_func_entry
+}
+
+func (context *Context) wrapFunctionEntry(z3FunctionEntry C.Z3_func_entry) *FunctionEntry {
+	context.check()
+
+	entry := &FunctionEntry{
+		context:         context,
+		z3FunctionEntry: z3FunctionEntry,
+	}
+
+	C.Z3_func_entry_inc_ref(context.z3Context, z3FunctionEntry)
+	runtime.SetFinalizer(entry, func(entry *FunctionEntry) {
+		context.release(func() {
+			C.Z3_func_entry_dec_ref(context.z3Context, entry.z3FunctionEntry)
+		})
+	})
+
+	return entry
+}
+
+// Return the value of the function for the arguments of the entry.
+func (entry *FunctionEntry) Value() *AST {
+	return compute(entry.context, func() *AST {
+		return entry.context.wrapAST(
+			C.Z3_func_entry_get_value(entry.context.z3Context, entry.z3FunctionEntry),
+		)
+	}, entry)
+}
+
+// Return the number of arguments of the entry.
+func (entry *FunctionEntry) NumArgs() uint {
+	return compute(entry.context, func() uint {
+		return uint(C.Z3_func_entry_get_num_args(entry.context.z3Context, entry.z3FunctionEntry))
+	}, entry)
+}
+
+// Return the argument at position idx of the entry.
+func (entry *FunctionEntry) Arg(idx uint) *AST {
+	return compute(entry.context, func() *AST {
+		return entry.context.wrapAST(
+			C.Z3_func_entry_get_arg(entry.context.z3Context, entry.z3FunctionEntry, C.uint(idx)),
+		)
+	}, entry)
+}
+
+// Return all arguments of the entry.
+func (entry *FunctionEntry) Args() []*AST {
+	return compute(entry.context, func() []*AST {
+		args := make([]*AST, C.Z3_func_entry_get_num_args(entry.context.z3Context, entry.z3FunctionEntry))
+		for idx := range args {
+			args[idx] = entry.context.wrapAST(
+				C.Z3_func_entry_get_arg(entry.context.z3Context, entry.z3FunctionEntry, C.uint(idx)),
+			)
+		}
+		return args
+	}, entry)
+}
@@ -0,0 +1,201 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+import "runtime"
+
+// GoalPrecision describes how a goal relates to the original goal it was derived from.
+type GoalPrecision int
+
+// The different precisions of goals.
+const (
+	GoalPrecise   = GoalPrecision(C.Z3_GOAL_PRECISE)    // Approximations/Relaxations were not applied on the goal
+	GoalUnder     = GoalPrecision(C.Z3_GOAL_UNDER)      // Goal is the product of a under-approximation
+	GoalOver      = GoalPrecision(C.Z3_GOAL_OVER)       // Goal is the product of an over-approximation
+	GoalUnderOver = GoalPrecision(C.Z3_GOAL_UNDER_OVER) // Goal is garbage (it is the product of over- and under-approximations)
+)
+
+func (precision GoalPrecision) String() string {
+	switch precision {
+	case GoalPrecise:
+		return "precise"
+	case GoalUnder:
+		return "under"
+	case GoalOver:
+		return "over"
+	case GoalUnderOver:
+		return "under-over"
+	}
+	return "invalid"
+}
+
+// Set of formulas that can be solved and/or transformed using tactics and solvers.
+type Goal struct {
+	context *Context
+	z3Goal  C.Z3_goal
+}
+
+func (context *Context) wrapGoal(z3Goal C.Z3_goal) *Goal {
+	context.check()
+
+	goal := &Goal{
+		context: context,
+		z3Goal:  z3Goal,
+	}
+
+	C.Z3_goal_inc_ref(context.z3Context, z3Goal)
+	runtime.SetFinalizer(goal, func(goal *Goal) {
+		context.release(func() {
+			C.Z3_goal_dec_ref(context.z3Context, goal.z3Goal)
+		})
+	})
+
+	return goal
+}
+
+// Create a goal.
+//
+// If models is true, then model generation is enabled for the new goal.
+// If unsatCores is true, then unsat core generation is enabled for the new goal.
+// If proofs is true, then proof generation is enabled for the new goal.
+// Proof generation also has to be enabled in the configuration of the context.
+func (context *Context) NewGoal(models, unsatCores, proofs bool) *Goal {
+	return compute(context, func() *Goal {
+		return context.wrapGoal(
+			C.Z3_mk_goal(context.z3Context, C.bool(models), C.bool(unsatCores), C.bool(proofs)),
+		)
+	})
+}
+
+func (goal *Goal) Context() *Context {
+	return goal.context
+}
+
+// Add the given formulas to the goal.
+// Panics with an *Error if one of the formulas is not Boolean. The formulas before it are added nonetheless.
+func (goal *Goal) Assert(formulas ...*AST) {
+	goal.context.do(func() {
+		for _, formula := range formulas {
+			C.Z3_goal_assert(goal.context.z3Context, goal.z3Goal, formula.z3AST)
+			goal.context.check()
+		}
+	}, goal, formulas)
+}
+
+// Return the precision of the goal. Goals can be transformed using over and under approximations.
+func (goal *Goal) Precision() GoalPrecision {
+	return compute(goal.context, func() GoalPrecision {
+		return GoalPrecision(C.Z3_goal_precision(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Return true if the goal contains the formula false.
+func (goal *Goal) Inconsistent() bool {
+	return compute(goal.context, func() bool {
+		return bool(C.Z3_goal_inconsistent(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Return the depth of the goal. It tracks how many transformations were applied to it.
+func (goal *Goal) Depth() uint {
+	return compute(goal.context, func() uint {
+		return uint(C.Z3_goal_depth(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Erase all formulas from the goal.
+func (goal *Goal) Reset() {
+	goal.context.do(func() {
+		C.Z3_goal_reset(goal.context.z3Context, goal.z3Goal)
+	}, goal)
+}
+
+// Return the number of formulas in the goal.
+func (goal *Goal) Size() uint {
+	return compute(goal.context, func() uint {
+		return uint(C.Z3_goal_size(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Return the formula at position idx of the goal.
+func (goal *Goal) Formula(idx uint) *AST {
+	return compute(goal.context, func() *AST {
+		return goal.context.wrapAST(
+			C.Z3_goal_formula(goal.context.z3Context, goal.z3Goal, C.uint(idx)),
+		)
+	}, goal)
+}
+
+// Return all formulas of the goal.
+func (goal *Goal) Formulas() []*AST {
+	return compute(goal.context, func() []*AST {
+		formulas := make([]*AST, C.Z3_goal_size(goal.context.z3Context, goal.z3Goal))
+		for idx := range formulas {
+			formulas[idx] = goal.context.wrapAST(
+				C.Z3_goal_formula(goal.context.z3Context, goal.z3Goal, C.uint(idx)),
+			)
+		}
+		return formulas
+	}, goal)
+}
+
+// Return the number of formulas, subformulas and terms in the goal.
+func (goal *Goal) NumExpressions() uint {
+	return compute(goal.context, func() uint {
+		return uint(C.Z3_goal_num_exprs(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Return true if the goal is empty, and it is precise or the product of a under approximation.
+func (goal *Goal) IsDecidedSat() bool {
+	return compute(goal.context, func() bool {
+		return bool(C.Z3_goal_is_decided_sat(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Return true if the goal contains false, and it is precise or the product of an over approximation.
+func (goal *Goal) IsDecidedUnsat() bool {
+	return compute(goal.context, func() bool {
+		return bool(C.Z3_goal_is_decided_unsat(goal.context.z3Context, goal.z3Goal))
+	}, goal)
+}
+
+// Convert a model of the formulas of the goal to a model of an original goal.
+// The model may be nil, in which case the returned model is valid if the goal
+// is established satisfiable.
+func (goal *Goal) ConvertModel(model *Model) *Model {
+	var z3Model C.Z3_model
+	if model != nil {
+		z3Model = model.z3Model
+	}
+
+	return compute(goal.context, func() *Model {
+		return goal.context.wrapModel(
+			C.Z3_goal_convert_model(goal.context.z3Context, goal.z3Goal, z3Model),
+		)
+	}, goal, model)
+}
+
+func (goal *Goal) String() string {
+	return compute(goal.context, func() string {
+		return C.GoString(
+			C.Z3_goal_to_string(goal.context.z3Context, goal.z3Goal),
+		)
+	}, goal)
+}
+
+// Convert the goal into a DIMACS formatted string.
+// The goal must be in CNF. You can convert a goal to CNF by applying the tseitin-cnf tactic.
+// Bit-vectors are not automatically converted to Booleans either, so if the caller intends
+// to preserve satisfiability, it should apply bit-blasting tactics.
+func (goal *Goal) Dimacs(includeNames bool) string {
+	return compute(goal.context, func() string {
+		return C.GoString(
+			C.Z3_goal_to_dimacs_string(goal.context.z3Context, goal.z3Goal, C.bool(includeNames)),
+		)
+	}, goal)
+}
@@ -0,0 +1,68 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoal(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	goal := context.NewGoal(true, false, false)
+
+	// Act
+	goal.Assert(GT(x, context.NewInt(0, integer)), LT(x, context.NewInt(10, integer)))
+
+	// Assert
+	assert.Equal(t, uint(2), goal.Size())
+	assert.Equal(t, uint(0), goal.Depth())
+	assert.Equal(t, GoalPrecise, goal.Precision())
+	assert.Equal(t, "(> x 0)", goal.Formula(0).String())
+	assert.Len(t, goal.Formulas(), 2)
+	assert.False(t, goal.Inconsistent())
+	assert.False(t, goal.IsDecidedSat())
+	assert.Contains(t, goal.String(), "(< x 10)")
+}
+
+func TestGoalInconsistent(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	goal := context.NewGoal(false, false, false)
+
+	// Act
+	goal.Assert(context.NewFalse())
+
+	// Assert
+	assert.True(t, goal.Inconsistent())
+	assert.True(t, goal.IsDecidedUnsat())
+	goal.Reset()
+	assert.Equal(t, uint(0), goal.Size())
+	assert.True(t, goal.IsDecidedSat())
+}
+
+func TestGoalAssertNonBoolean(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	goal := context.NewGoal(true, false, false)
+
+	// Act
+	_, err := Try(func() bool {
+		goal.Assert(x, GT(x, context.NewInt(0, integer)))
+		return true
+	})
+
+	// Assert
+	var z3Error *Error
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+	assert.Equal(t, uint(0), goal.Size())
+}
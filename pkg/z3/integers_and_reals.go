@@ -15,6 +15,13 @@ func Add(lhs *AST, rhs ...*AST) *AST {
 	)
 }
 
+// Same as Add, but returns the *Error reported by Z3 instead of panicking, e.g., for ill-sorted operands.
+func TryAdd(lhs *AST, rhs ...*AST) (*AST, error) {
+	return Try(func() *AST {
+		return Add(lhs, rhs...)
+	})
+}
+
 func Multiply(lhs *AST, rhs ...*AST) *AST {
 	return nary(
 		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
@@ -118,3 +125,24 @@ func IsInt(operand *AST) *AST {
 		}, operand,
 	)
 }
+
+// Coerce an integer to a real.
+func IntToReal(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_int2real(context, operand)
+		}, operand,
+	)
+}
+
+// Coerce a real to an integer.
+//
+// The semantics of this function follows the SMT-LIB standard
+// for the function to_int
+func RealToInt(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_real2int(context, operand)
+		}, operand,
+	)
+}
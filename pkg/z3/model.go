@@ -14,17 +14,17 @@ type Model struct {
 }
 
 func (context *Context) wrapModel(z3Model C.Z3_model) *Model {
+	context.check()
+
 	model := &Model{
 		context: context,
 		z3Model: z3Model,
 	}
 
-	context.do(func() {
-		C.Z3_model_inc_ref(context.z3Context, model.z3Model)
-	}, z3Model, context)
+	C.Z3_model_inc_ref(context.z3Context, model.z3Model)
 
 	runtime.SetFinalizer(model, func(model *Model) {
-		context.do(func() {
+		context.release(func() {
 			C.Z3_model_dec_ref(context.z3Context, model.z3Model)
 		}, model)
 	})
@@ -33,15 +33,19 @@ func (context *Context) wrapModel(z3Model C.Z3_model) *Model {
 }
 
 func (context *Context) NewModel() (model *Model) {
-	return context.wrapModel(
-		C.Z3_mk_model(context.z3Context),
-	)
+	return compute(context, func() *Model {
+		return context.wrapModel(
+			C.Z3_mk_model(context.z3Context),
+		)
+	})
 }
 
 func (solver *Solver) Model() (model *Model) {
-	return solver.context.wrapModel(
-		C.Z3_solver_get_model(solver.context.z3Context, solver.z3Sovler),
-	)
+	return compute(solver.context, func() *Model {
+		return solver.context.wrapModel(
+			C.Z3_solver_get_model(solver.context.z3Context, solver.z3Sovler),
+		)
+	}, solver)
 }
 
 // Evaluate the AST node in the given model.
@@ -85,3 +89,107 @@ func (model *Model) String() (text string) {
 		))
 	}, model)
 }
+
+// Return true if the model has an interpretation for the given constant or function.
+func (model *Model) HasInterpretation(declaration *FunctionDeclaration) bool {
+	return compute(model.context, func() bool {
+		return bool(C.Z3_model_has_interp(model.context.z3Context, model.z3Model, declaration.z3FunctionDeclaration))
+	}, model, declaration)
+}
+
+// Return the declarations of the constants that have an interpretation in the model.
+func (model *Model) ConstantDeclarations() []*FunctionDeclaration {
+	context := model.context
+	return compute(context, func() []*FunctionDeclaration {
+		declarations := make([]*FunctionDeclaration, C.Z3_model_get_num_consts(context.z3Context, model.z3Model))
+		for idx := range declarations {
+			declarations[idx] = context.wrapFunctionDeclaration(
+				C.Z3_model_get_const_decl(context.z3Context, model.z3Model, C.uint(idx)),
+			)
+		}
+		return declarations
+	}, model)
+}
+
+// Return the interpretation (i.e., value) of the constant in the model,
+// and false if the model does not assign an interpretation for the constant.
+func (model *Model) ConstantInterpretation(declaration *FunctionDeclaration) (value *AST, ok bool) {
+	model.context.do(func() {
+		z3Value := C.Z3_model_get_const_interp(model.context.z3Context, model.z3Model, declaration.z3FunctionDeclaration)
+		if z3Value != nil {
+			value, ok = model.context.wrapAST(z3Value), true
+		}
+	}, model, declaration)
+	return
+}
+
+// Return the declarations of the functions that have an interpretation in the model.
+// Constants are not included.
+func (model *Model) FunctionDeclarations() []*FunctionDeclaration {
+	context := model.context
+	return compute(context, func() []*FunctionDeclaration {
+		declarations := make([]*FunctionDeclaration, C.Z3_model_get_num_funcs(context.z3Context, model.z3Model))
+		for idx := range declarations {
+			declarations[idx] = context.wrapFunctionDeclaration(
+				C.Z3_model_get_func_decl(context.z3Context, model.z3Model, C.uint(idx)),
+			)
+		}
+		return declarations
+	}, model)
+}
+
+// Return the interpretation of the function in the model,
+// and false if the model does not assign an interpretation for the function.
+func (model *Model) FunctionInterpretation(declaration *FunctionDeclaration) (interpretation *FunctionInterpretation, ok bool) {
+	model.context.do(func() {
+		z3Interpretation := C.Z3_model_get_func_interp(
+			model.context.z3Context, model.z3Model, declaration.z3FunctionDeclaration,
+		)
+		if z3Interpretation != nil {
+			interpretation, ok = model.context.wrapFunctionInterpretation(z3Interpretation), true
+		}
+	}, model, declaration)
+	return
+}
+
+// Return the uninterpreted sorts that have an interpretation in the model.
+func (model *Model) Sorts() []*Sort {
+	context := model.context
+	return compute(context, func() []*Sort {
+		sorts := make([]*Sort, C.Z3_model_get_num_sorts(context.z3Context, model.z3Model))
+		for idx := range sorts {
+			sorts[idx] = context.wrapSort(
+				C.Z3_model_get_sort(context.z3Context, model.z3Model, C.uint(idx)),
+			)
+		}
+		return sorts
+	}, model)
+}
+
+// Return the finite set of distinct values that represent the interpretation of the uninterpreted sort.
+func (model *Model) SortUniverse(sort *Sort) []*AST {
+	return compute(model.context, func() []*AST {
+		return model.context.wrapASTs(
+			C.Z3_model_get_sort_universe(model.context.z3Context, model.z3Model, sort.z3Sort),
+		)
+	}, model, sort)
+}
+
+// Add the interpretation (i.e., value) of the constant to the model.
+func (model *Model) AddConstantInterpretation(declaration *FunctionDeclaration, value *AST) {
+	model.context.do(func() {
+		C.Z3_add_const_interp(model.context.z3Context, model.z3Model, declaration.z3FunctionDeclaration, value.z3AST)
+	}, model, declaration, value)
+}
+
+// Add an interpretation of the function to the model. The interpretation has no entries and evaluates
+// to the default value for all arguments. Use AddEntry of the interpretation to add entries.
+func (model *Model) AddFunctionInterpretation(declaration *FunctionDeclaration, defaultValue *AST) *FunctionInterpretation {
+	return compute(model.context, func() *FunctionInterpretation {
+		return model.context.wrapFunctionInterpretation(
+			C.Z3_add_func_interp(
+				model.context.z3Context, model.z3Model, declaration.z3FunctionDeclaration, defaultValue.z3AST,
+			),
+		)
+	}, model, declaration, defaultValue)
+}
@@ -0,0 +1,141 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelInterpretations(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	xDeclaration := context.NewFunctionDeclaration(WithName("x"), nil, integer)
+	fDeclaration := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer}, integer)
+	x := xDeclaration.Application(nil)
+	f := func(argument int) *AST { return fDeclaration.Application([]*AST{context.NewInt(argument, integer)}) }
+	solver.Assert(Eq(x, context.NewInt(3, integer)))
+	solver.Assert(Eq(f(1), context.NewInt(2, integer)))
+	solver.Assert(Eq(f(2), context.NewInt(5, integer)))
+	assert.True(t, solver.Check().IsTrue())
+
+	// Act
+	model := solver.Model()
+	constants := model.ConstantDeclarations()
+	functions := model.FunctionDeclarations()
+	value, hasValue := model.ConstantInterpretation(xDeclaration)
+	interpretation, hasInterpretation := model.FunctionInterpretation(fDeclaration)
+
+	// Assert
+	assert.Len(t, constants, 1)
+	assert.Equal(t, xDeclaration.AST().String(), constants[0].AST().String())
+	assert.Len(t, functions, 1)
+	assert.True(t, model.HasInterpretation(xDeclaration))
+	assert.True(t, model.HasInterpretation(fDeclaration))
+	assert.True(t, hasValue)
+	assert.Equal(t, "3", value.String())
+	assert.True(t, hasInterpretation)
+	assert.Equal(t, uint(1), interpretation.Arity())
+	entries := make(map[string]string)
+	for _, entry := range interpretation.Entries() {
+		assert.Equal(t, uint(1), entry.NumArgs())
+		entries[entry.Args()[0].String()] = entry.Value().String()
+	}
+	assert.Equal(t, interpretation.NumEntries(), uint(len(entries)))
+	lookup := func(argument string) string {
+		if value, ok := entries[argument]; ok {
+			return value
+		}
+		return interpretation.Else().String()
+	}
+	assert.Equal(t, "2", lookup("1"))
+	assert.Equal(t, "5", lookup("2"))
+}
+
+func TestModelWithoutInterpretation(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	model := context.NewModel()
+	declaration := context.NewFunctionDeclaration(WithName("x"), nil, context.IntegerSort())
+
+	// Act
+	_, hasValue := model.ConstantInterpretation(declaration)
+	_, hasInterpretation := model.FunctionInterpretation(declaration)
+
+	// Assert
+	assert.False(t, hasValue)
+	assert.False(t, hasInterpretation)
+	assert.False(t, model.HasInterpretation(declaration))
+	assert.Empty(t, model.ConstantDeclarations())
+}
+
+func TestModelConstruction(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	xDeclaration := context.NewFunctionDeclaration(WithName("x"), nil, integer)
+	fDeclaration := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer}, integer)
+	model := context.NewModel()
+
+	// Act
+	model.AddConstantInterpretation(xDeclaration, context.NewInt(7, integer))
+	interpretation := model.AddFunctionInterpretation(fDeclaration, context.NewInt(0, integer))
+	interpretation.AddEntry([]*AST{context.NewInt(1, integer)}, context.NewInt(10, integer))
+
+	// Assert
+	eval := func(ast *AST) string {
+		_, value := model.Eval(ast, true)
+		return value.String()
+	}
+	assert.Equal(t, "7", eval(xDeclaration.Application(nil)))
+	assert.Equal(t, "10", eval(fDeclaration.Application([]*AST{context.NewInt(1, integer)})))
+	assert.Equal(t, "0", eval(fDeclaration.Application([]*AST{context.NewInt(2, integer)})))
+	interpretation.SetElse(context.NewInt(-1, integer))
+	assert.Equal(t, "(- 1)", eval(fDeclaration.Application([]*AST{context.NewInt(2, integer)})))
+}
+
+func TestModelSortUniverse(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	assertions, err := context.Parse("(declare-sort U) (declare-const a U) (declare-const b U) (assert (distinct a b))")
+	assert.NoError(t, err)
+	for _, assertion := range assertions {
+		solver.Assert(assertion)
+	}
+	assert.True(t, solver.Check().IsTrue())
+
+	// Act
+	model := solver.Model()
+	sorts := model.Sorts()
+
+	// Assert
+	assert.Len(t, sorts, 1)
+	assert.Equal(t, KindUninterpreted, sorts[0].Kind())
+	assert.Len(t, model.SortUniverse(sorts[0]), 2)
+}
+
+func TestModelNotAvailable(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	solver.Assert(Distinct(x, x))
+	solver.Check()
+
+	// Act
+	model, err := Try(solver.Model)
+
+	// Assert
+	var z3Error *Error
+	assert.Nil(t, model)
+	assert.True(t, errors.As(err, &z3Error))
+	assert.True(t, context.NewSolver().HasSolution())
+}
@@ -0,0 +1,192 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Create a numeral of the given sort from its string representation.
+//
+// The string may be a (negative) integer, a decimal such as "1.25", or a fraction such as "-1/3".
+// The sort can be an integer, real, finite-domain or bit-vector sort.
+// Panics with an *Error if the string is not a numeral of the sort.
+func (context *Context) NewNumeral(numeral string, sort *Sort) *AST {
+	// Allocate an unmanged string and make sure it is freed.
+	cNumeral := C.CString(numeral)
+	defer C.free(unsafe.Pointer(cNumeral))
+
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_numeral(context.z3Context, cNumeral, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a numeral of the given sort from a 64-bit integer.
+// The sort can be an integer, real, finite-domain or bit-vector sort.
+func (context *Context) NewInt64(value int64, sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_int64(context.z3Context, C.int64_t(value), sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a numeral of the given sort from a 64-bit unsigned integer.
+// The sort can be an integer, real, finite-domain or bit-vector sort.
+func (context *Context) NewUint64(value uint64, sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_unsigned_int64(context.z3Context, C.uint64_t(value), sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a numeral of the given sort from an arbitrary precision integer.
+// The sort can be an integer, real, finite-domain or bit-vector sort.
+func (context *Context) NewBigInt(value *big.Int, sort *Sort) *AST {
+	return context.NewNumeral(value.String(), sort)
+}
+
+// Create a numeral of the given sort from an arbitrary precision rational.
+// The sort is usually a real sort.
+func (context *Context) NewBigRat(value *big.Rat, sort *Sort) *AST {
+	return context.NewNumeral(value.RatString(), sort)
+}
+
+// Return true if the AST is a numeral, e.g., an integer, real or bit-vector constant.
+func (ast *AST) IsNumeral() bool {
+	return compute(ast.context, func() bool {
+		return bool(C.Z3_is_numeral_ast(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return true if the AST is a real algebraic number, e.g., the result of evaluating (root-obj (+ (^ x 2) (- 2)) 2).
+func (ast *AST) IsAlgebraicNumber() bool {
+	return compute(ast.context, func() bool {
+		return bool(C.Z3_is_algebraic_number(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return the numeral as a 64-bit integer, and false if it is not a numeral or does not fit.
+func (ast *AST) Int64() (value int64, ok bool) {
+	ast.context.do(func() {
+		if !bool(C.Z3_is_numeral_ast(ast.context.z3Context, ast.z3AST)) {
+			return
+		}
+		var cValue C.int64_t
+		ok = bool(C.Z3_get_numeral_int64(ast.context.z3Context, ast.z3AST, &cValue))
+		value = int64(cValue)
+	}, ast)
+	return
+}
+
+// Return the numeral as a 64-bit unsigned integer, and false if it is not a numeral or does not fit.
+func (ast *AST) Uint64() (value uint64, ok bool) {
+	ast.context.do(func() {
+		if !bool(C.Z3_is_numeral_ast(ast.context.z3Context, ast.z3AST)) {
+			return
+		}
+		var cValue C.uint64_t
+		ok = bool(C.Z3_get_numeral_uint64(ast.context.z3Context, ast.z3AST, &cValue))
+		value = uint64(cValue)
+	}, ast)
+	return
+}
+
+// Return the numeral as an arbitrary precision rational, and false if it is not a numeral.
+// Bit-vector numerals are interpreted as unsigned integers.
+func (ast *AST) BigRat() (*big.Rat, bool) {
+	numeral, ok := ast.numeralString()
+	if !ok {
+		return nil, false
+	}
+	return new(big.Rat).SetString(numeral)
+}
+
+// Return the numeral as an arbitrary precision integer, and false if it is not an integral numeral.
+// Bit-vector numerals are interpreted as unsigned integers.
+func (ast *AST) BigInt() (*big.Int, bool) {
+	value, ok := ast.BigRat()
+	if !ok || !value.IsInt() {
+		return nil, false
+	}
+	return value.Num(), true
+}
+
+// Return the numeral or an approximation of the algebraic number as a 64-bit floating-point number,
+// and false if the AST is neither of them. Large values are rounded to infinity.
+func (ast *AST) Float64() (float64, bool) {
+	if value, ok := ast.BigRat(); ok {
+		float, _ := value.Float64()
+		return float, true
+	}
+
+	decimal, ok := ast.DecimalString(20)
+	if !ok {
+		return 0, false
+	}
+	float, err := strconv.ParseFloat(strings.TrimSuffix(decimal, "?"), 64)
+	return float, err == nil
+}
+
+// Return the numeral or the algebraic number in decimal notation with at most precision decimal places,
+// and false if the AST is neither of them. A truncated result ends with a question mark.
+func (ast *AST) DecimalString(precision uint) (value string, ok bool) {
+	ast.context.do(func() {
+		z3Context := ast.context.z3Context
+		if !bool(C.Z3_is_numeral_ast(z3Context, ast.z3AST)) && !bool(C.Z3_is_algebraic_number(z3Context, ast.z3AST)) {
+			return
+		}
+		value, ok = C.GoString(C.Z3_get_numeral_decimal_string(z3Context, ast.z3AST, C.uint(precision))), true
+	}, ast)
+	return
+}
+
+// Return a rational lower bound of the algebraic number with a distance of at most 1/10^precision to it.
+// Panics with an *Error if the AST is not an algebraic number.
+func (ast *AST) AlgebraicLower(precision uint) *AST {
+	return compute(ast.context, func() *AST {
+		return ast.context.wrapAST(
+			C.Z3_get_algebraic_number_lower(ast.context.z3Context, ast.z3AST, C.uint(precision)),
+		)
+	}, ast)
+}
+
+// Return a rational upper bound of the algebraic number with a distance of at most 1/10^precision to it.
+// Panics with an *Error if the AST is not an algebraic number.
+func (ast *AST) AlgebraicUpper(precision uint) *AST {
+	return compute(ast.context, func() *AST {
+		return ast.context.wrapAST(
+			C.Z3_get_algebraic_number_upper(ast.context.z3Context, ast.z3AST, C.uint(precision)),
+		)
+	}, ast)
+}
+
+// Return the value of a Boolean constant, and false if the AST is neither true nor false.
+func (ast *AST) Bool() (value bool, ok bool) {
+	lifted := compute(ast.context, func() LiftedBoolean {
+		return LiftedBoolean(C.Z3_get_bool_value(ast.context.z3Context, ast.z3AST))
+	}, ast)
+	return lifted.IsTrue(), !lifted.IsUndefined()
+}
+
+// Return the numeral in the rational format of Z3, e.g., "-1/3", and false if the AST is not a numeral.
+func (ast *AST) numeralString() (value string, ok bool) {
+	ast.context.do(func() {
+		if !bool(C.Z3_is_numeral_ast(ast.context.z3Context, ast.z3AST)) {
+			return
+		}
+		value, ok = C.GoString(C.Z3_get_numeral_string(ast.context.z3Context, ast.z3AST)), true
+	}, ast)
+	return
+}
@@ -0,0 +1,131 @@
+package z3
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntegerNumerals(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	// Act
+	small := context.NewInt(-42, integer)
+	large := context.NewInt(math.MaxInt64, integer)
+	unsigned := context.NewUint64(math.MaxUint64, integer)
+	bigNumeral := context.NewBigInt(huge, integer)
+
+	// Assert
+	value, ok := small.Int64()
+	assert.True(t, ok)
+	assert.Equal(t, int64(-42), value)
+	_, ok = small.Uint64()
+	assert.False(t, ok)
+	value, ok = large.Int64()
+	assert.True(t, ok)
+	assert.Equal(t, int64(math.MaxInt64), value)
+	unsignedValue, ok := unsigned.Uint64()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(math.MaxUint64), unsignedValue)
+	_, ok = bigNumeral.Int64()
+	assert.False(t, ok)
+	bigValue, ok := bigNumeral.BigInt()
+	assert.True(t, ok)
+	assert.Equal(t, huge, bigValue)
+	assert.True(t, bigNumeral.IsNumeral())
+}
+
+func TestRealNumerals(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	realSort := context.RealSort()
+
+	// Act
+	third := context.NewBigRat(big.NewRat(-1, 3), realSort)
+	decimal := context.NewNumeral("1.25", realSort)
+
+	// Assert
+	value, ok := third.BigRat()
+	assert.True(t, ok)
+	assert.Equal(t, big.NewRat(-1, 3), value)
+	_, ok = third.BigInt()
+	assert.False(t, ok)
+	float, ok := decimal.Float64()
+	assert.True(t, ok)
+	assert.Equal(t, 1.25, float)
+	text, ok := third.DecimalString(3)
+	assert.True(t, ok)
+	assert.Equal(t, "-0.333?", text)
+	assert.Panics(t, func() { context.NewNumeral("one", realSort) })
+}
+
+func TestBitVectorNumerals(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	numeral := context.NewBitVector(-1, 8)
+
+	// Assert
+	value, ok := numeral.Uint64()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(255), value)
+	bigValue, ok := numeral.BigInt()
+	assert.True(t, ok)
+	assert.Equal(t, big.NewInt(255), bigValue)
+}
+
+func TestAlgebraicNumerals(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	realSort := context.RealSort()
+	x := context.NewConstant(WithName("x"), realSort)
+	solver.Assert(Eq(Multiply(x, x), context.NewNumeral("2", realSort)))
+	solver.Assert(GT(x, context.NewNumeral("0", realSort)))
+	assert.True(t, solver.Check().IsTrue())
+
+	// Act
+	_, root := solver.Model().Eval(x, true)
+
+	// Assert
+	assert.True(t, root.IsAlgebraicNumber())
+	_, ok := root.BigRat()
+	assert.False(t, ok)
+	float, ok := root.Float64()
+	assert.True(t, ok)
+	assert.InDelta(t, math.Sqrt2, float, 1e-9)
+	lower, _ := root.AlgebraicLower(5).BigRat()
+	upper, _ := root.AlgebraicUpper(5).BigRat()
+	assert.Equal(t, -1, lower.Cmp(upper))
+}
+
+func TestBooleanValues(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+
+	// Act
+	trueValue, trueOK := context.NewTrue().Bool()
+	falseValue, falseOK := context.NewFalse().Bool()
+	_, constantOK := p.Bool()
+
+	// Assert
+	assert.True(t, trueValue)
+	assert.True(t, trueOK)
+	assert.False(t, falseValue)
+	assert.True(t, falseOK)
+	assert.False(t, constantOK)
+	_, ok := p.Int64()
+	assert.False(t, ok)
+}
@@ -7,6 +7,10 @@ package z3
 */
 import "C"
 
+// The helpers below perform an operation on operands of the same context.
+// If Z3 rejects the operation, e.g., because the operands are ill-sorted,
+// they panic with an *Error. Use Try to receive the error instead.
+
 func unary(
 	operation func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast, operand *AST,
 ) *AST {
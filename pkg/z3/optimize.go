@@ -0,0 +1,258 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	gocontext "context"
+	"math/big"
+	"runtime"
+	"strconv"
+	"unsafe"
+)
+
+// Context for solving optimization queries.
+type Optimize struct {
+	context    *Context
+	z3Optimize C.Z3_optimize
+
+	// canceled is set if the last check was canceled by its Go context.
+	canceled bool
+}
+
+// Priority determines how multiple objectives of an Optimize context are combined.
+type Priority string
+
+const (
+	// Optimize the objectives in the order they were added.
+	PriorityLexicographic = Priority("lex")
+	// Enumerate the Pareto optimal solutions of the objectives on subsequent calls to Check.
+	PriorityPareto = Priority("pareto")
+	// Optimize every objective independently of the others.
+	PriorityBox = Priority("box")
+)
+
+// Create a new optimize context.
+func (context *Context) NewOptimize() (optimize *Optimize) {
+	context.do(func() {
+		optimize = &Optimize{
+			context:    context,
+			z3Optimize: C.Z3_mk_optimize(context.z3Context),
+		}
+
+		// User must use Z3_optimize_inc_ref and Z3_optimize_dec_ref to manage optimize objects.
+		// Even if the context was created using Z3_mk_context instead of Z3_mk_context_rc.
+		C.Z3_optimize_inc_ref(context.z3Context, optimize.z3Optimize)
+	})
+
+	runtime.SetFinalizer(optimize, func(optimize *Optimize) {
+		context.release(func() {
+			C.Z3_optimize_dec_ref(context.z3Context, optimize.z3Optimize)
+		})
+	})
+
+	return optimize
+}
+
+func (optimize *Optimize) Context() *Context {
+	return optimize.context
+}
+
+// Assert hard constraint to the optimization context.
+func (optimize *Optimize) Assert(ast *AST) {
+	optimize.context.do(func() {
+		C.Z3_optimize_assert(optimize.context.z3Context, optimize.z3Optimize, ast.z3AST)
+	}, optimize, ast)
+}
+
+// Assert soft constraint to the optimization context.
+//
+// The weight is the penalty for violating the constraint. Soft constraints with the same group
+// are minimized together. A nil group adds the constraint to the default group.
+// The returned index identifies the objective of the group.
+func (optimize *Optimize) AssertSoft(ast *AST, weight uint, group SymbolFactory) uint {
+	return optimize.assertSoft(ast, strconv.FormatUint(uint64(weight), 10), group)
+}
+
+// Assert soft constraint with a rational weight to the optimization context, see AssertSoft.
+// The weight must be positive.
+func (optimize *Optimize) AssertSoftRat(ast *AST, weight *big.Rat, group SymbolFactory) uint {
+	return optimize.assertSoft(ast, weight.RatString(), group)
+}
+
+func (optimize *Optimize) assertSoft(ast *AST, weight string, group SymbolFactory) uint {
+	if group == nil {
+		group = WithName("")
+	}
+	symbol := group(optimize.context)
+
+	// Allocate an unmanged string and make sure it is freed.
+	cWeight := C.CString(weight)
+	defer C.free(unsafe.Pointer(cWeight))
+
+	return compute(optimize.context, func() uint {
+		return uint(C.Z3_optimize_assert_soft(
+			optimize.context.z3Context, optimize.z3Optimize,
+			ast.z3AST, cWeight, symbol.z3Symbol,
+		))
+	}, optimize, ast)
+}
+
+// Add a maximization constraint. The returned index identifies the objective.
+func (optimize *Optimize) Maximize(ast *AST) uint {
+	return compute(optimize.context, func() uint {
+		return uint(C.Z3_optimize_maximize(optimize.context.z3Context, optimize.z3Optimize, ast.z3AST))
+	}, optimize, ast)
+}
+
+// Add a minimization constraint. The returned index identifies the objective.
+func (optimize *Optimize) Minimize(ast *AST) uint {
+	return compute(optimize.context, func() uint {
+		return uint(C.Z3_optimize_minimize(optimize.context.z3Context, optimize.z3Optimize, ast.z3AST))
+	}, optimize, ast)
+}
+
+// Set how multiple objectives are combined. The default priority is lexicographic.
+func (optimize *Optimize) SetPriority(priority Priority) {
+	params := optimize.context.NewParams()
+	params.SetSymbol("priority", string(priority))
+	optimize.SetParams(params)
+}
+
+// Create a backtracking point.
+//
+// The optimize context is a stack of assertions and objectives.
+// A Pop removes the assertions and objectives added since the matching Push.
+func (optimize *Optimize) Push() {
+	optimize.context.do(func() {
+		C.Z3_optimize_push(optimize.context.z3Context, optimize.z3Optimize)
+	}, optimize)
+}
+
+// Backtrack one level.
+func (optimize *Optimize) Pop() {
+	optimize.context.do(func() {
+		C.Z3_optimize_pop(optimize.context.z3Context, optimize.z3Optimize)
+	}, optimize)
+}
+
+// Check consistency and produce optimal values.
+// The assumptions are additional constraints that only hold during this check.
+func (optimize *Optimize) Check(assumptions ...*AST) LiftedBoolean {
+	z3Assumptions := make([]C.Z3_ast, len(assumptions))
+	for idx := range assumptions {
+		z3Assumptions[idx] = assumptions[idx].z3AST
+	}
+
+	return compute(optimize.context, func() LiftedBoolean {
+		optimize.canceled = false
+		return LiftedBoolean(
+			C.Z3_optimize_check(
+				optimize.context.z3Context, optimize.z3Optimize,
+				C.uint(len(z3Assumptions)), pointerTo(z3Assumptions),
+			),
+		)
+	}, optimize, assumptions)
+}
+
+// Same as Check, but interrupts the optimization when the Go context is canceled or its deadline passes.
+// If the optimization is interrupted, the result is undefined and ReasonUnknown returns "canceled".
+func (optimize *Optimize) CheckContext(ctx gocontext.Context, assumptions ...*AST) LiftedBoolean {
+	result, done := interruptible(optimize.context, ctx, func() LiftedBoolean {
+		return optimize.Check(assumptions...)
+	})
+	if done && result.IsUndefined() {
+		optimize.context.do(func() {
+			optimize.canceled = true
+		}, optimize)
+	}
+	return result
+}
+
+// Retrieve a string that describes the last status returned by Check.
+// Use this method when Check returns undefined.
+func (optimize *Optimize) ReasonUnknown() string {
+	return compute(optimize.context, func() string {
+		if optimize.canceled {
+			return "canceled"
+		}
+		return C.GoString(
+			C.Z3_optimize_get_reason_unknown(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
+
+// Retrieve the model for the last Check.
+// Panics with an *Error if Z3 reports that no model is available.
+func (optimize *Optimize) Model() *Model {
+	return compute(optimize.context, func() *Model {
+		return optimize.context.wrapModel(
+			C.Z3_optimize_get_model(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
+
+// Retrieve lower bound value or approximation for the i'th optimization objective.
+func (optimize *Optimize) Lower(index uint) *AST {
+	return compute(optimize.context, func() *AST {
+		return optimize.context.wrapAST(
+			C.Z3_optimize_get_lower(optimize.context.z3Context, optimize.z3Optimize, C.uint(index)),
+		)
+	}, optimize)
+}
+
+// Retrieve upper bound value or approximation for the i'th optimization objective.
+func (optimize *Optimize) Upper(index uint) *AST {
+	return compute(optimize.context, func() *AST {
+		return optimize.context.wrapAST(
+			C.Z3_optimize_get_upper(optimize.context.z3Context, optimize.z3Optimize, C.uint(index)),
+		)
+	}, optimize)
+}
+
+// Return the set of asserted formulas on the optimization context.
+func (optimize *Optimize) Assertions() []*AST {
+	return compute(optimize.context, func() []*AST {
+		return optimize.context.wrapASTs(
+			C.Z3_optimize_get_assertions(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
+
+// Return objectives on the optimization context.
+// If the objective function is a max-sat objective it is returned
+// as a Pseudo-Boolean (minimization) sum of the form (+ (if f1 w1 0) (if f2 w2 0) ...)
+// If the objective function is entered as a maximization objective, then the latter is returned
+// as a minimization objective.
+func (optimize *Optimize) Objectives() []*AST {
+	return compute(optimize.context, func() []*AST {
+		return optimize.context.wrapASTs(
+			C.Z3_optimize_get_objectives(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
+
+// Parse an SMT-LIB2 string with assertions, soft constraints and optimization
+// objectives. Add the parsed constraints and objectives to the optimization context.
+func (optimize *Optimize) FromString(str string) {
+	// Allocate an unmanged string and make sure it is freed.
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+
+	optimize.context.do(func() {
+		C.Z3_optimize_from_string(optimize.context.z3Context, optimize.z3Optimize, cStr)
+	}, optimize)
+}
+
+// Print the current context as a string in SMT-LIB2 format.
+func (optimize *Optimize) String() string {
+	return compute(optimize.context, func() string {
+		return C.GoString(
+			C.Z3_optimize_to_string(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
@@ -0,0 +1,190 @@
+package z3
+
+import (
+	gocontext "context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeMaximize(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	optimize.Assert(LE(Add(x, y), context.NewInt(10, integer)))
+	optimize.Assert(GE(x, context.NewInt(0, integer)))
+	optimize.Assert(GE(y, context.NewInt(0, integer)))
+
+	// Act
+	objective := optimize.Maximize(Add(x, Multiply(context.NewInt(2, integer), y)))
+	sat := optimize.Check()
+
+	// Assert
+	assert.True(t, sat.IsTrue())
+	assert.Equal(t, "20", optimize.Upper(objective).String())
+	assert.Equal(t, "20", optimize.Lower(objective).String())
+	_, value := optimize.Model().Eval(y, true)
+	assert.Equal(t, "10", value.String())
+}
+
+func TestOptimizeSoftConstraints(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	a := context.NewConstant(WithName("a"), context.BooleanSort())
+	b := context.NewConstant(WithName("b"), context.BooleanSort())
+	optimize.Assert(Not(And(a, b)))
+
+	// Act
+	optimize.AssertSoft(a, 1, WithName("preferences"))
+	objective := optimize.AssertSoft(b, 2, WithName("preferences"))
+	sat := optimize.Check()
+
+	// Assert
+	assert.True(t, sat.IsTrue())
+	assert.Equal(t, "1", optimize.Lower(objective).String())
+	_, value := optimize.Model().Eval(b, true)
+	assert.Equal(t, "true", value.String())
+}
+
+func TestOptimizeSoftConstraintsRationalWeights(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	a := context.NewConstant(WithName("a"), context.BooleanSort())
+	b := context.NewConstant(WithName("b"), context.BooleanSort())
+	optimize.Assert(Not(And(a, b)))
+
+	// Act
+	optimize.AssertSoftRat(a, big.NewRat(3, 2), nil)
+	objective := optimize.AssertSoftRat(b, big.NewRat(5, 4), nil)
+	sat := optimize.Check()
+
+	// Assert
+	assert.True(t, sat.IsTrue())
+	lower, ok := optimize.Lower(objective).BigRat()
+	assert.True(t, ok)
+	assert.Equal(t, big.NewRat(5, 4), lower)
+	_, value := optimize.Model().Eval(a, true)
+	assert.Equal(t, "true", value.String())
+}
+
+func TestOptimizePushPop(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	optimize.Assert(LE(x, context.NewInt(5, integer)))
+	objective := optimize.Maximize(x)
+
+	// Act
+	optimize.Push()
+	optimize.Assert(LE(x, context.NewInt(3, integer)))
+	optimize.Check()
+	inner := optimize.Upper(objective).String()
+	optimize.Pop()
+	optimize.Check()
+	outer := optimize.Upper(objective).String()
+
+	// Assert
+	assert.Equal(t, "3", inner)
+	assert.Equal(t, "5", outer)
+	assert.Len(t, optimize.Assertions(), 1)
+	assert.Len(t, optimize.Objectives(), 1)
+}
+
+func TestOptimizeAssertionsOutliveLaterOperations(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	optimize.Assert(GE(x, context.NewInt(0, integer)))
+	optimize.Assert(LE(x, context.NewInt(5, integer)))
+	optimize.Minimize(x)
+
+	// Act
+	assertions := optimize.Assertions()
+	objectives := optimize.Objectives()
+	context.NewSolver()
+	context.Parse("(declare-const y Int) (assert (> y 0))")
+
+	// Assert
+	assert.Len(t, assertions, 2)
+	assert.Equal(t, "(<= x 5)", assertions[1].String())
+	assert.Len(t, objectives, 1)
+	assert.Equal(t, "x", objectives[0].String())
+}
+
+func TestOptimizeBox(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	optimize.Assert(LE(Add(x, y), context.NewInt(10, integer)))
+	optimize.Assert(GE(x, context.NewInt(0, integer)))
+	optimize.Assert(GE(y, context.NewInt(0, integer)))
+	optimize.SetPriority(PriorityBox)
+
+	// Act
+	first := optimize.Maximize(x)
+	second := optimize.Maximize(y)
+	optimize.Check()
+
+	// Assert
+	assert.Equal(t, "10", optimize.Upper(first).String())
+	assert.Equal(t, "10", optimize.Upper(second).String())
+}
+
+func TestOptimizeFromString(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+
+	// Act
+	optimize.FromString(`
+	(declare-const x Int)
+	(assert (< x 7))
+	(maximize x)
+	`)
+	optimize.Check()
+
+	// Assert
+	assert.Equal(t, "6", optimize.Upper(0).String())
+	assert.Contains(t, optimize.String(), "(maximize x)")
+}
+
+func TestOptimizeCheckContextCanceled(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	optimize := context.NewOptimize()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	optimize.Assert(LE(x, context.NewInt(10, integer)))
+	optimize.Maximize(x)
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+
+	// Act
+	result := optimize.CheckContext(ctx)
+
+	// Assert
+	assert.True(t, result.IsUndefined())
+	assert.Equal(t, "canceled", optimize.ReasonUnknown())
+	assert.True(t, optimize.CheckContext(gocontext.Background()).IsTrue())
+}
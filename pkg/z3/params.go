@@ -0,0 +1,323 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// ParamKind is the type of a parameter.
+type ParamKind int
+
+// The different kinds of parameters.
+const (
+	ParamKindUint    = ParamKind(C.Z3_PK_UINT)    // Integer parameters
+	ParamKindBool    = ParamKind(C.Z3_PK_BOOL)    // Boolean parameters
+	ParamKindDouble  = ParamKind(C.Z3_PK_DOUBLE)  // Double parameters
+	ParamKindSymbol  = ParamKind(C.Z3_PK_SYMBOL)  // Symbol parameters
+	ParamKindString  = ParamKind(C.Z3_PK_STRING)  // String parameters
+	ParamKindOther   = ParamKind(C.Z3_PK_OTHER)   // All internal parameter kinds which are not exposed in the API
+	ParamKindInvalid = ParamKind(C.Z3_PK_INVALID) // Invalid parameter
+)
+
+func (kind ParamKind) String() string {
+	switch kind {
+	case ParamKindUint:
+		return "uint"
+	case ParamKindBool:
+		return "bool"
+	case ParamKindDouble:
+		return "double"
+	case ParamKindSymbol:
+		return "symbol"
+	case ParamKindString:
+		return "string"
+	case ParamKindOther:
+		return "other"
+	}
+	return "invalid"
+}
+
+// Parameter set used to configure many components such as: simplifiers, tactics, solvers, etc.
+type Params struct {
+	context  *Context
+	z3Params C.Z3_params
+
+	// Kinds of the parameters that were set. Used to validate the parameters.
+	kinds map[string]ParamKind
+}
+
+// Create a parameter set.
+func (context *Context) NewParams() (params *Params) {
+	context.do(func() {
+		params = &Params{
+			context:  context,
+			z3Params: C.Z3_mk_params(context.z3Context),
+			kinds:    make(map[string]ParamKind),
+		}
+
+		C.Z3_params_inc_ref(context.z3Context, params.z3Params)
+	})
+
+	runtime.SetFinalizer(params, func(params *Params) {
+		context.release(func() {
+			C.Z3_params_dec_ref(context.z3Context, params.z3Params)
+		})
+	})
+
+	return params
+}
+
+// Add a Boolean parameter with the given key and value.
+func (params *Params) SetBool(key string, value bool) {
+	symbol := params.context.NewStringSymbol(key)
+	params.context.do(func() {
+		C.Z3_params_set_bool(params.context.z3Context, params.z3Params, symbol.z3Symbol, C.bool(value))
+		params.kinds[key] = ParamKindBool
+	}, params)
+}
+
+// Add an unsigned parameter with the given key and value.
+func (params *Params) SetUint(key string, value uint) {
+	symbol := params.context.NewStringSymbol(key)
+	params.context.do(func() {
+		C.Z3_params_set_uint(params.context.z3Context, params.z3Params, symbol.z3Symbol, C.uint(value))
+		params.kinds[key] = ParamKindUint
+	}, params)
+}
+
+// Add a double parameter with the given key and value.
+func (params *Params) SetDouble(key string, value float64) {
+	symbol := params.context.NewStringSymbol(key)
+	params.context.do(func() {
+		C.Z3_params_set_double(params.context.z3Context, params.z3Params, symbol.z3Symbol, C.double(value))
+		params.kinds[key] = ParamKindDouble
+	}, params)
+}
+
+// Add a symbol parameter with the given key and value.
+func (params *Params) SetSymbol(key string, value string) {
+	symbol := params.context.NewStringSymbol(key)
+	valueSymbol := params.context.NewStringSymbol(value)
+	params.context.do(func() {
+		C.Z3_params_set_symbol(params.context.z3Context, params.z3Params, symbol.z3Symbol, valueSymbol.z3Symbol)
+		params.kinds[key] = ParamKindSymbol
+	}, params)
+}
+
+// Validate the parameter set with respect to the given parameter descriptions.
+// An error is returned for the first parameter that is unknown or has a different kind.
+func (params *Params) Validate(descriptions *ParamDescriptions) error {
+	for key, kind := range params.kinds {
+		expected := descriptions.Kind(key)
+		if expected == ParamKindInvalid {
+			return fmt.Errorf("unknown parameter %q", key)
+		}
+		// Symbols and strings are interchangeable.
+		if expected != kind && !(kind == ParamKindSymbol && expected == ParamKindString) {
+			return fmt.Errorf("parameter %q must be of kind %s but is %s", key, expected, kind)
+		}
+	}
+	return nil
+}
+
+// Convert a parameter set into a string.
+// This function is mainly used for printing the contents of a parameter set.
+func (params *Params) String() string {
+	return compute(params.context, func() string {
+		return C.GoString(C.Z3_params_to_string(params.context.z3Context, params.z3Params))
+	}, params)
+}
+
+// Descriptions of the parameters accepted by a component such as a solver.
+type ParamDescriptions struct {
+	context             *Context
+	z3ParamDescriptions C.Z3_param_descrs
+}
+
+func (context *Context) wrapParamDescriptions(descriptions C.Z3_param_descrs) *ParamDescriptions {
+	context.check()
+
+	paramDescriptions := &ParamDescriptions{
+		context:             context,
+		z3ParamDescriptions: descriptions,
+	}
+
+	C.Z3_param_descrs_inc_ref(context.z3Context, descriptions)
+	runtime.SetFinalizer(paramDescriptions, func(descriptions *ParamDescriptions) {
+		context.release(func() {
+			C.Z3_param_descrs_dec_ref(context.z3Context, descriptions.z3ParamDescriptions)
+		})
+	})
+
+	return paramDescriptions
+}
+
+// Return the names of the described parameters.
+func (descriptions *ParamDescriptions) Names() []string {
+	context := descriptions.context
+	symbols := compute(context, func() []Symbol {
+		symbols := make([]Symbol, C.Z3_param_descrs_size(context.z3Context, descriptions.z3ParamDescriptions))
+		for idx := range symbols {
+			symbols[idx] = Symbol{
+				context: context,
+				z3Symbol: C.Z3_param_descrs_get_name(
+					context.z3Context, descriptions.z3ParamDescriptions, C.uint(idx),
+				),
+			}
+		}
+		return symbols
+	}, descriptions)
+
+	names := make([]string, len(symbols))
+	for idx := range symbols {
+		names[idx] = symbols[idx].String()
+	}
+	return names
+}
+
+// Return the kind associated with the given parameter name.
+// The kind is invalid if the parameter is unknown.
+func (descriptions *ParamDescriptions) Kind(name string) ParamKind {
+	symbol := descriptions.context.NewStringSymbol(name)
+	return compute(descriptions.context, func() ParamKind {
+		return ParamKind(C.Z3_param_descrs_get_kind(
+			descriptions.context.z3Context, descriptions.z3ParamDescriptions, symbol.z3Symbol,
+		))
+	}, descriptions)
+}
+
+// Retrieve the documentation string corresponding to the given parameter name.
+func (descriptions *ParamDescriptions) Documentation(name string) string {
+	symbol := descriptions.context.NewStringSymbol(name)
+	return compute(descriptions.context, func() string {
+		return C.GoString(C.Z3_param_descrs_get_documentation(
+			descriptions.context.z3Context, descriptions.z3ParamDescriptions, symbol.z3Symbol,
+		))
+	}, descriptions)
+}
+
+// Convert the parameter descriptions into a string.
+func (descriptions *ParamDescriptions) String() string {
+	return compute(descriptions.context, func() string {
+		return C.GoString(C.Z3_param_descrs_to_string(
+			descriptions.context.z3Context, descriptions.z3ParamDescriptions,
+		))
+	}, descriptions)
+}
+
+// Set a value of a context parameter.
+// Only parameters that can be updated after the creation of the context are accepted.
+func (context *Context) SetParam(key, value string) {
+	// Allocate unmanged strings and make sure they are freed.
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	cValue := C.CString(value)
+	defer C.free(unsafe.Pointer(cValue))
+
+	context.do(func() {
+		C.Z3_update_param_value(context.z3Context, cKey, cValue)
+	})
+}
+
+// Set a global (or module) parameter.
+// This setting is shared by all Z3 contexts.
+//
+// When a Z3 module is initialized it will use the value of these parameters
+// when Z3_params objects are not provided.
+//
+// The name of parameter can be composed of characters [a-z][A-Z], digits [0-9], '-' and '_'.
+// The character '.' is a delimiter (more later).
+//
+// The parameter names are case-insensitive. The character '-' should be viewed as an "alias" for '_'.
+// Thus, the following parameter names are considered equivalent: "pp.decimal-precision"
+// and "PP.DECIMAL_PRECISION".
+//
+// This function can be used to set parameters for a specific Z3 module.
+// This can be done by using <module-name>.<parameter-name>.
+// For example: SetGlobalParam("pp.decimal", "true")
+// will set the parameter "decimal" in the module "pp" to true.
+func SetGlobalParam(key, value string) {
+	// Allocate unmanged strings and make sure they are freed.
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	cValue := C.CString(value)
+	defer C.free(unsafe.Pointer(cValue))
+
+	C.Z3_global_param_set(cKey, cValue)
+}
+
+// Get a global (or module) parameter.
+// Return false if the parameter value does not exist.
+func GlobalParam(key string) (value string, ok bool) {
+	// Allocate an unmanged string and make sure it is freed.
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	var cValue C.Z3_string
+	if ok = bool(C.Z3_global_param_get(cKey, &cValue)); ok {
+		value = C.GoString(cValue)
+	}
+	return
+}
+
+// Restore the value of all global (and module) parameters.
+// This command will not affect already created objects (such as tactics and solvers).
+func ResetGlobalParams() {
+	C.Z3_global_param_reset_all()
+}
+
+// Set the given solver using the given parameters.
+func (solver *Solver) SetParams(params *Params) {
+	solver.context.do(func() {
+		C.Z3_solver_set_params(solver.context.z3Context, solver.z3Sovler, params.z3Params)
+	}, solver, params)
+}
+
+// Return the parameter description set for the given solver object.
+func (solver *Solver) ParamDescriptions() *ParamDescriptions {
+	return compute(solver.context, func() *ParamDescriptions {
+		return solver.context.wrapParamDescriptions(
+			C.Z3_solver_get_param_descrs(solver.context.z3Context, solver.z3Sovler),
+		)
+	}, solver)
+}
+
+// Set parameters on the optimization context.
+func (optimize *Optimize) SetParams(params *Params) {
+	optimize.context.do(func() {
+		C.Z3_optimize_set_params(optimize.context.z3Context, optimize.z3Optimize, params.z3Params)
+	}, optimize, params)
+}
+
+// Return the parameter description set for the given optimize object.
+func (optimize *Optimize) ParamDescriptions() *ParamDescriptions {
+	return compute(optimize.context, func() *ParamDescriptions {
+		return optimize.context.wrapParamDescriptions(
+			C.Z3_optimize_get_param_descrs(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
+
+// Set parameters on the fixedpoint context.
+func (fixedpoint *Fixedpoint) SetParams(params *Params) {
+	fixedpoint.context.do(func() {
+		C.Z3_fixedpoint_set_params(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint, params.z3Params)
+	}, fixedpoint, params)
+}
+
+// Return the parameter description set for the given fixedpoint object.
+func (fixedpoint *Fixedpoint) ParamDescriptions() *ParamDescriptions {
+	return compute(fixedpoint.context, func() *ParamDescriptions {
+		return fixedpoint.context.wrapParamDescriptions(
+			C.Z3_fixedpoint_get_param_descrs(fixedpoint.context.z3Context, fixedpoint.z3Fixedpoint),
+		)
+	}, fixedpoint)
+}
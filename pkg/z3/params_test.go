@@ -0,0 +1,102 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigSetters(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	config.SetModel(true)
+	config.SetUnsatCore(true)
+	config.SetTimeout(time.Second)
+	config.SetEncoding(EncodingASCII)
+	context := NewContext(config)
+	solver := context.NewSolver()
+	s := context.NewConstant(WithName("s"), context.StringSort())
+
+	// Act
+	solver.Assert(Eq(SeqLength(s), context.NewInt(1, context.IntegerSort())))
+	solver.Assert(Not(StrLE(s, context.NewString("ÿ"))))
+
+	// Assert
+	assert.True(t, solver.Check().IsFalse())
+}
+
+func TestConfigSetUnknownKey(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+
+	// Act
+	unknown := config.Set("no_such_param", "true")
+	known := config.Set("Model-Validate", "true")
+	_, negative := Try(func() bool {
+		config.SetTimeout(-time.Second)
+		return true
+	})
+
+	// Assert
+	var z3Error *Error
+	assert.True(t, errors.As(unknown, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+	assert.Contains(t, unknown.Error(), "no_such_param")
+	assert.NoError(t, known)
+	assert.True(t, errors.As(negative, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+}
+
+func TestSolverParams(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	params := context.NewParams()
+	descriptions := solver.ParamDescriptions()
+
+	// Act
+	params.SetUint("timeout", 1000)
+	params.SetBool("unsat_core", true)
+	solver.SetParams(params)
+
+	// Assert
+	assert.NoError(t, params.Validate(descriptions))
+	assert.Equal(t, "(params timeout 1000 unsat_core true)", params.String())
+	assert.Contains(t, descriptions.Names(), "timeout")
+	assert.Equal(t, ParamKindUint, descriptions.Kind("timeout"))
+	assert.NotEmpty(t, descriptions.Documentation("timeout"))
+}
+
+func TestParamsValidate(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	descriptions := context.NewSolver().ParamDescriptions()
+
+	// Act
+	unknown := context.NewParams()
+	unknown.SetBool("no_such_parameter", true)
+	mistyped := context.NewParams()
+	mistyped.SetBool("timeout", true)
+
+	// Assert
+	assert.ErrorContains(t, unknown.Validate(descriptions), "unknown parameter")
+	assert.ErrorContains(t, mistyped.Validate(descriptions), "must be of kind uint")
+	assert.Equal(t, ParamKindInvalid, descriptions.Kind("no_such_parameter"))
+}
+
+func TestGlobalParams(t *testing.T) {
+	// Arrange
+	defer ResetGlobalParams()
+
+	// Act
+	SetGlobalParam("pp.decimal", "true")
+	value, ok := GlobalParam("pp.decimal")
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, "true", value)
+}
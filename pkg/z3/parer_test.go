@@ -1,6 +1,9 @@
 package z3
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,7 +15,7 @@ func Test(t *testing.T) {
 	context := NewContext(config)
 
 	// Act
-	asts := context.Parse(`
+	asts, err := context.Parse(`
 	(declare-const x Int)
 	(assert (= x 10))
 	(define-fun max_integ ((x Int) (y Int)) Int 
@@ -24,8 +27,95 @@ func Test(t *testing.T) {
 	`)
 
 	// Assert
-	assert.Equal(t, uint(3), asts.Length())
-	assert.Equal(t, "(= x 10)", asts.Get(0).String())
-	assert.Equal(t, "(= (ite (< 10 0) 10 0) 10)", asts.Get(1).String())
-	assert.Equal(t, "(= (ite (< 10 0) 0 10) 0)", asts.Get(2).String())
+	assert.NoError(t, err)
+	assert.Len(t, asts, 3)
+	assert.Equal(t, "(= x 10)", asts[0].String())
+	assert.Equal(t, "(= (ite (< 10 0) 10 0) 10)", asts[1].String())
+	assert.Equal(t, "(= (ite (< 10 0) 0 10) 0)", asts[2].String())
+}
+
+func TestParseWithDeclarations(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	user := context.UninterpretedSort(WithName("User"))
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	age := context.NewFunctionDeclaration(WithName("age"), []*Sort{user}, integer)
+
+	// Act
+	asts, err := context.Parse(`
+		(declare-const alice Person)
+		(assert (> (years alice) limit))
+	`,
+		WithSorts(map[string]*Sort{"Person": user}),
+		WithDeclarations(map[string]*FunctionDeclaration{"years": age, "limit": x.Decl()}),
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, asts, 1)
+	assert.Equal(t, "(> (age alice) x)", asts[0].String())
+	assert.True(t, asts[0].Arg(1).Equals(x))
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	_, err := context.Parse("(declare-const y Int)\n(assert (> x 0))")
+
+	// Assert
+	var parseError *ParseError
+	var z3Error *Error
+	assert.True(t, errors.As(err, &parseError))
+	assert.Equal(t, 2, parseError.Line)
+	assert.Equal(t, 11, parseError.Column)
+	assert.Equal(t, "unknown constant x", parseError.Message)
+	assert.Equal(t, "z3: parser error at line 2 column 11: unknown constant x", err.Error())
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeParserError, z3Error.Code)
+}
+
+func TestParseFile(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	path := filepath.Join(t.TempDir(), "problem.smt2")
+	assert.NoError(t, os.WriteFile(path, []byte("(declare-const x Int)\n(assert (= x 10))\n"), 0o600))
+
+	// Act
+	asts, err := context.ParseFile(path)
+	_, missing := context.ParseFile(filepath.Join(t.TempDir(), "missing.smt2"))
+
+	// Assert
+	var z3Error *Error
+	assert.NoError(t, err)
+	assert.Len(t, asts, 1)
+	assert.Equal(t, "(= x 10)", asts[0].String())
+	assert.True(t, errors.As(missing, &z3Error))
+	assert.Equal(t, ErrorCodeFileAccessError, z3Error.Code)
+}
+
+func TestEvalSMTLIB2(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	first, _ := context.EvalSMTLIB2("(declare-const x Int)\n(assert (> x 0))\n(check-sat)")
+	second, _ := context.EvalSMTLIB2("(assert (< x 0))\n(check-sat)")
+	_, err := context.EvalSMTLIB2("(assert (> z 0))")
+	reset, resetErr := context.EvalSMTLIB2("(reset)\n(check-sat)")
+
+	// Assert
+	var parseError *ParseError
+	assert.Equal(t, "sat\n", first)
+	assert.Equal(t, "unsat\n", second)
+	assert.True(t, errors.As(err, &parseError))
+	assert.Equal(t, "unknown constant z", parseError.Message)
+	assert.NoError(t, resetErr)
+	assert.Equal(t, "sat\n", reset)
 }
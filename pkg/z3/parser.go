@@ -0,0 +1,183 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"unsafe"
+)
+
+// Additional sorts and declarations the SMT-LIB2 parser may refer to.
+type parseOptions struct {
+	sorts        map[string]*Sort
+	declarations map[string]*FunctionDeclaration
+}
+
+// ParseOption makes sorts and declarations created in Go available to the SMT-LIB2 parser.
+type ParseOption func(options *parseOptions)
+
+// Make the given sorts available to the parser under the names they are mapped from.
+// The parsed text may use them without declaring them.
+func WithSorts(sorts map[string]*Sort) ParseOption {
+	return func(options *parseOptions) {
+		for name, sort := range sorts {
+			options.sorts[name] = sort
+		}
+	}
+}
+
+// Make the given function declarations available to the parser under the names they are mapped from.
+// Constants are functions without parameters, use AST.Decl to obtain their declaration.
+func WithDeclarations(declarations map[string]*FunctionDeclaration) ParseOption {
+	return func(options *parseOptions) {
+		for name, declaration := range declarations {
+			options.declarations[name] = declaration
+		}
+	}
+}
+
+// The Z3 representation of the parse options.
+type z3ParseOptions struct {
+	sortNames        []C.Z3_symbol
+	sorts            []C.Z3_sort
+	declarationNames []C.Z3_symbol
+	declarations     []C.Z3_func_decl
+}
+
+func newParseOptions(context *Context, options []ParseOption) (z3Options z3ParseOptions, keeps []any) {
+	parse := parseOptions{
+		sorts:        make(map[string]*Sort),
+		declarations: make(map[string]*FunctionDeclaration),
+	}
+	for _, option := range options {
+		option(&parse)
+	}
+
+	// The symbols have to be created before acquiring the lock of the context.
+	for name, sort := range parse.sorts {
+		z3Options.sortNames = append(z3Options.sortNames, context.NewStringSymbol(name).z3Symbol)
+		z3Options.sorts = append(z3Options.sorts, sort.z3Sort)
+		keeps = append(keeps, sort)
+	}
+	for name, declaration := range parse.declarations {
+		z3Options.declarationNames = append(z3Options.declarationNames, context.NewStringSymbol(name).z3Symbol)
+		z3Options.declarations = append(z3Options.declarations, declaration.z3FunctionDeclaration)
+		keeps = append(keeps, declaration)
+	}
+	return z3Options, keeps
+}
+
+// Parse the given string using the SMT-LIB2 parser and return the asserted formulas.
+// The options make sorts and declarations created in Go available to the parsed text.
+// The error is a *ParseError if the string cannot be parsed.
+func (context *Context) Parse(str string, options ...ParseOption) ([]*AST, error) {
+	// Allocate an unmanged string and make sure it is freed.
+	cStr := C.CString(str)
+	defer C.free(unsafe.Pointer(cStr))
+
+	z3Options, keeps := newParseOptions(context, options)
+	asts, err := Try(func() []*AST {
+		return compute(context, func() []*AST {
+			return context.wrapASTs(
+				C.Z3_parse_smtlib2_string(
+					context.z3Context, cStr,
+					C.uint(len(z3Options.sorts)), pointerTo(z3Options.sortNames), pointerTo(z3Options.sorts),
+					C.uint(len(z3Options.declarations)), pointerTo(z3Options.declarationNames), pointerTo(z3Options.declarations),
+				),
+			)
+		}, keeps...)
+	})
+	return asts, newParseError(err)
+}
+
+// Parse the file with the given path using the SMT-LIB2 parser and return the asserted formulas.
+// The error is a *ParseError if the file cannot be parsed, and an *Error if it cannot be read.
+func (context *Context) ParseFile(path string, options ...ParseOption) ([]*AST, error) {
+	// Allocate an unmanged string and make sure it is freed.
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	z3Options, keeps := newParseOptions(context, options)
+	asts, err := Try(func() []*AST {
+		return compute(context, func() []*AST {
+			return context.wrapASTs(
+				C.Z3_parse_smtlib2_file(
+					context.z3Context, cPath,
+					C.uint(len(z3Options.sorts)), pointerTo(z3Options.sortNames), pointerTo(z3Options.sorts),
+					C.uint(len(z3Options.declarations)), pointerTo(z3Options.declarationNames), pointerTo(z3Options.declarations),
+				),
+			)
+		}, keeps...)
+	})
+	return asts, newParseError(err)
+}
+
+// Evaluate the SMT-LIB2 commands of the script and return their output, e.g., "sat\n" for (check-sat).
+//
+// The commands are evaluated in a command context that is associated with the context and
+// preserved between calls, so later scripts may refer to declarations of earlier ones.
+// The error is a *ParseError if a command fails.
+func (context *Context) EvalSMTLIB2(script string) (string, error) {
+	// Allocate an unmanged string and make sure it is freed.
+	cScript := C.CString(script)
+	defer C.free(unsafe.Pointer(cScript))
+
+	output, err := Try(func() string {
+		return compute(context, func() string {
+			return C.GoString(C.Z3_eval_smtlib2_string(context.z3Context, cScript))
+		})
+	})
+	return output, newParseError(err)
+}
+
+// Error reported by the SMT-LIB2 parser or by a command of EvalSMTLIB2,
+// with the position of the error in the text if Z3 reported it.
+type ParseError struct {
+	Line    int // Line of the error, starting at 1, or 0 if unknown
+	Column  int // Column of the error, or 0 if unknown
+	Message string
+
+	err *Error
+}
+
+func (err *ParseError) Error() string {
+	if err.Line == 0 {
+		return "z3: parser error: " + err.Message
+	}
+	return fmt.Sprintf("z3: parser error at line %d column %d: %s", err.Line, err.Column, err.Message)
+}
+
+// Return the underlying *Error reported by Z3.
+func (err *ParseError) Unwrap() error {
+	return err.err
+}
+
+// Z3 reports parser errors as (error "line 1 column 9: unknown constant x").
+var parseErrorPattern = regexp.MustCompile(`\(error "line (\d+) column (\d+): ((?:[^"\\]|\\.)*)"\)`)
+
+// Convert an *Error reported while parsing into a *ParseError. Other errors are returned unchanged.
+func newParseError(err error) error {
+	var z3Error *Error
+	if !errors.As(err, &z3Error) {
+		return err
+	}
+	if z3Error.Code != ErrorCodeParserError && z3Error.Code != ErrorCodeException {
+		return err
+	}
+
+	parseError := &ParseError{Message: z3Error.Message, err: z3Error}
+	if match := parseErrorPattern.FindStringSubmatch(z3Error.Message); match != nil {
+		parseError.Line, _ = strconv.Atoi(match[1])
+		parseError.Column, _ = strconv.Atoi(match[2])
+		parseError.Message = match[3]
+	}
+	return parseError
+}
@@ -0,0 +1,176 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+// Function/predicate used to inspect a goal and collect information that may be used to decide which solver
+// and/or preprocessing step will be used. Probes evaluate to a double, where Boolean probes use 0.0 for false.
+type Probe struct {
+	context *Context
+	z3Probe C.Z3_probe
+}
+
+func (context *Context) wrapProbe(z3Probe C.Z3_probe) *Probe {
+	context.check()
+
+	probe := &Probe{
+		context: context,
+		z3Probe: z3Probe,
+	}
+
+	C.Z3_probe_inc_ref(context.z3Context, z3Probe)
+	runtime.SetFinalizer(probe, func(probe *Probe) {
+		context.release(func() {
+			C.Z3_probe_dec_ref(context.z3Context, probe.z3Probe)
+		})
+	})
+
+	return probe
+}
+
+// Return a probe associated with the given name.
+// Panics with an *Error if no probe with the given name exists.
+func (context *Context) NewProbe(name string) *Probe {
+	// Allocate an unmanged string and make sure it is freed.
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return compute(context, func() *Probe {
+		return context.wrapProbe(
+			C.Z3_mk_probe(context.z3Context, cName),
+		)
+	})
+}
+
+func (probe *Probe) Context() *Context {
+	return probe.context
+}
+
+// Return the names of all probes supported by Z3.
+func (context *Context) ProbeNames() []string {
+	return compute(context, func() []string {
+		names := make([]string, C.Z3_get_num_probes(context.z3Context))
+		for idx := range names {
+			names[idx] = C.GoString(C.Z3_get_probe_name(context.z3Context, C.uint(idx)))
+		}
+		return names
+	})
+}
+
+// Return a string containing a description of the probe with the given name.
+func (context *Context) ProbeDescription(name string) string {
+	// Allocate an unmanged string and make sure it is freed.
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return compute(context, func() string {
+		return C.GoString(C.Z3_probe_get_descr(context.z3Context, cName))
+	})
+}
+
+// Return a probe that always evaluates to the given value.
+func (context *Context) NewProbeConstant(value float64) *Probe {
+	return compute(context, func() *Probe {
+		return context.wrapProbe(
+			C.Z3_probe_const(context.z3Context, C.double(value)),
+		)
+	})
+}
+
+// Execute the probe over the goal.
+// The probe always produces a double value, where Boolean probes return 0.0 for false, and a value different from 0.0 for true.
+func (probe *Probe) Apply(goal *Goal) float64 {
+	return compute(probe.context, func() float64 {
+		return float64(C.Z3_probe_apply(probe.context.z3Context, probe.z3Probe, goal.z3Goal))
+	}, probe, goal)
+}
+
+// Execute the Boolean probe over the goal.
+func (probe *Probe) Holds(goal *Goal) bool {
+	return probe.Apply(goal) != 0
+}
+
+// Return a probe that evaluates to "true" when the value returned by probe is less than the value returned by other.
+func (probe *Probe) LT(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_lt(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when the value returned by probe is greater than the value returned by other.
+func (probe *Probe) GT(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_gt(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when the value returned by probe is less than or equal to the value returned by other.
+func (probe *Probe) LE(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_le(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when the value returned by probe is greater than or equal to the value returned by other.
+func (probe *Probe) GE(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_ge(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when the value returned by probe is equal to the value returned by other.
+func (probe *Probe) Eq(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_eq(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when probe and other evaluate to true.
+func (probe *Probe) And(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_and(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when probe or other evaluates to true.
+func (probe *Probe) Or(other *Probe) *Probe {
+	return probe.combine(other, func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe {
+		return C.Z3_probe_or(context, lhs, rhs)
+	})
+}
+
+// Return a probe that evaluates to "true" when probe does not evaluate to true.
+func (probe *Probe) Not() *Probe {
+	return compute(probe.context, func() *Probe {
+		return probe.context.wrapProbe(
+			C.Z3_probe_not(probe.context.z3Context, probe.z3Probe),
+		)
+	}, probe)
+}
+
+func (probe *Probe) combine(other *Probe, combinator func(context C.Z3_context, lhs, rhs C.Z3_probe) C.Z3_probe) *Probe {
+	return compute(probe.context, func() *Probe {
+		return probe.context.wrapProbe(
+			combinator(probe.context.z3Context, probe.z3Probe, other.z3Probe),
+		)
+	}, probe, other)
+}
+
+// Evaluate the probes with the given names over the goal, e.g., to log metrics of a problem.
+// Panics with an *Error if no probe with one of the given names exists.
+func (goal *Goal) Measure(names ...string) map[string]float64 {
+	metrics := make(map[string]float64, len(names))
+	for _, name := range names {
+		metrics[name] = goal.context.NewProbe(name).Apply(goal)
+	}
+	return metrics
+}
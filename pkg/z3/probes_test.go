@@ -0,0 +1,90 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeApply(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	y := context.NewConstant(WithName("y"), context.BitVectorSort(8))
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(BVULT(x, y), Eq(BVAdd(x, y), context.NewBitVector(10, 8)))
+
+	// Act
+	constants := context.NewProbe("num-consts").Apply(goal)
+	bitVectors := context.NewProbe("is-qfbv").Holds(goal)
+	arithmetic := context.NewProbe("is-qflia").Holds(goal)
+
+	// Assert
+	assert.Equal(t, 2.0, constants)
+	assert.True(t, bitVectors)
+	assert.False(t, arithmetic)
+	assert.Equal(t, 2.0, goal.Measure("num-consts", "size")["size"])
+}
+
+func TestProbeCombinators(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.IntegerSort())
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(GT(x, context.NewInt(0, context.IntegerSort())))
+	size := context.NewProbe("size")
+	one := context.NewProbeConstant(1)
+	two := context.NewProbeConstant(2)
+
+	// Act & Assert
+	assert.True(t, size.Eq(one).Holds(goal))
+	assert.True(t, size.LT(two).Holds(goal))
+	assert.True(t, size.LE(one).Holds(goal))
+	assert.False(t, size.GT(one).Holds(goal))
+	assert.True(t, size.GE(one).Holds(goal))
+	assert.True(t, size.Eq(one).And(size.LT(two)).Holds(goal))
+	assert.True(t, size.Eq(two).Or(size.Eq(one)).Holds(goal))
+	assert.True(t, size.Eq(two).Not().Holds(goal))
+}
+
+func TestProbeStrategy(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.IntegerSort())
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(GT(x, context.NewInt(0, context.IntegerSort())))
+	small := context.NewProbe("num-consts").LT(context.NewProbeConstant(5))
+	tactic := Cond(small, context.NewTactic("smt"), context.FailTactic())
+
+	// Act
+	result := tactic.Apply(goal)
+
+	// Assert
+	assert.True(t, result.Subgoal(0).IsDecidedSat())
+	assert.Contains(t, context.ProbeNames(), "num-consts")
+	assert.NotEmpty(t, context.ProbeDescription("num-consts"))
+}
+
+func TestProbeTactics(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(BVUGT(x, context.NewBitVector(3, 8)))
+
+	// Act
+	conditional := Cond(context.NewProbe("is-qfbv"), AndThen(context.NewTactic("simplify"), context.NewTactic("bit-blast")), context.FailTactic()).Apply(goal)
+	unconditional := When(context.NewProbe("is-qflia"), context.FailTactic()).Apply(goal)
+	_, err := Try(func() *ApplyResult {
+		return FailIf(context.NewProbe("is-qfbv")).Apply(goal)
+	})
+
+	// Assert
+	assert.NotEqual(t, goal.String(), conditional.Subgoal(0).String())
+	assert.Equal(t, goal.Size(), unconditional.Subgoal(0).Size())
+	assert.Error(t, err)
+}
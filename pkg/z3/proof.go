@@ -0,0 +1,204 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// ProofRule is the inference rule of a step in a proof object.
+type ProofRule int
+
+// The inference rules of Z3 proof objects.
+const (
+	ProofRuleUndefined                     = ProofRule(C.Z3_OP_PR_UNDEF)             // Undef/Null proof object.
+	ProofRuleTrue                          = ProofRule(C.Z3_OP_PR_TRUE)              // Proof for the expression 'true'.
+	ProofRuleAsserted                      = ProofRule(C.Z3_OP_PR_ASSERTED)          // Proof for a fact asserted by the user.
+	ProofRuleGoal                          = ProofRule(C.Z3_OP_PR_GOAL)              // Proof for a fact (tagged as goal) asserted by the user.
+	ProofRuleModusPonens                   = ProofRule(C.Z3_OP_PR_MODUS_PONENS)      // Given a proof for p and a proof for (implies p q), produces a proof for q.
+	ProofRuleReflexivity                   = ProofRule(C.Z3_OP_PR_REFLEXIVITY)       // A proof for (R t t), where R is a reflexive relation.
+	ProofRuleSymmetry                      = ProofRule(C.Z3_OP_PR_SYMMETRY)          // Given an symmetric relation R and a proof for (R t s), produces a proof for (R s t).
+	ProofRuleTransitivity                  = ProofRule(C.Z3_OP_PR_TRANSITIVITY)      // Given a transitive relation R, and proofs for (R t s) and (R s u), produces a proof for (R t u).
+	ProofRuleTransitivityStar              = ProofRule(C.Z3_OP_PR_TRANSITIVITY_STAR) // Condensed transitivity proof.
+	ProofRuleMonotonicity                  = ProofRule(C.Z3_OP_PR_MONOTONICITY)      // Monotonicity proof object.
+	ProofRuleQuantifierIntroduction        = ProofRule(C.Z3_OP_PR_QUANT_INTRO)       // Given a proof for (~ p q), produces a proof for (~ (forall (x) p) (forall (x) q)).
+	ProofRuleBind                          = ProofRule(C.Z3_OP_PR_BIND)              // Given a proof p, produces a proof of lambda x . p, where x are free variables in p.
+	ProofRuleDistributivity                = ProofRule(C.Z3_OP_PR_DISTRIBUTIVITY)    // Distributivity proof object.
+	ProofRuleAndElimination                = ProofRule(C.Z3_OP_PR_AND_ELIM)          // Given a proof for (and l_1 ... l_n), produces a proof for l_i.
+	ProofRuleNotOrElimination              = ProofRule(C.Z3_OP_PR_NOT_OR_ELIM)       // Given a proof for (not (or l_1 ... l_n)), produces a proof for (not l_i).
+	ProofRuleRewrite                       = ProofRule(C.Z3_OP_PR_REWRITE)           // A proof for a local rewriting step (= t s).
+	ProofRuleRewriteStar                   = ProofRule(C.Z3_OP_PR_REWRITE_STAR)      // A proof for rewriting an expression t into an expression s.
+	ProofRulePullQuantifier                = ProofRule(C.Z3_OP_PR_PULL_QUANT)        // A proof for (iff (f (forall (x) q(x)) r) (forall (x) (f (q x) r))).
+	ProofRulePushQuantifier                = ProofRule(C.Z3_OP_PR_PUSH_QUANT)        // A proof for pushing a quantifier over a conjunction.
+	ProofRuleEliminateUnusedVariables      = ProofRule(C.Z3_OP_PR_ELIM_UNUSED_VARS)  // A proof for the elimination of unused variables of a quantifier.
+	ProofRuleDestructiveEqualityResolution = ProofRule(C.Z3_OP_PR_DER)               // A proof for destructive equality resolution.
+	ProofRuleQuantifierInstantiation       = ProofRule(C.Z3_OP_PR_QUANT_INST)        // A proof of (or (not (forall (x) (P x))) (P a)).
+	ProofRuleHypothesis                    = ProofRule(C.Z3_OP_PR_HYPOTHESIS)        // Mark a hypothesis in a natural deduction style proof.
+	ProofRuleLemma                         = ProofRule(C.Z3_OP_PR_LEMMA)             // Discharge the hypotheses of a proof of false.
+	ProofRuleUnitResolution                = ProofRule(C.Z3_OP_PR_UNIT_RESOLUTION)   // Unit resolution of a clause with the negations of some of its literals.
+	ProofRuleIFFTrue                       = ProofRule(C.Z3_OP_PR_IFF_TRUE)          // Given a proof for p, produces a proof for (iff p true).
+	ProofRuleIFFFalse                      = ProofRule(C.Z3_OP_PR_IFF_FALSE)         // Given a proof for (not p), produces a proof for (iff p false).
+	ProofRuleCommutativity                 = ProofRule(C.Z3_OP_PR_COMMUTATIVITY)     // A proof for (= (f a b) (f b a)), where f is commutative.
+	ProofRuleDefinitionAxiom               = ProofRule(C.Z3_OP_PR_DEF_AXIOM)         // Proof object used to justify Tseitin's like axioms.
+	ProofRuleAssumptionAdd                 = ProofRule(C.Z3_OP_PR_ASSUMPTION_ADD)    // Clausal proof adding an axiom.
+	ProofRuleLemmaAdd                      = ProofRule(C.Z3_OP_PR_LEMMA_ADD)         // Clausal proof lemma addition.
+	ProofRuleRedundantDelete               = ProofRule(C.Z3_OP_PR_REDUNDANT_DEL)     // Clausal proof lemma deletion.
+	ProofRuleClauseTrail                   = ProofRule(C.Z3_OP_PR_CLAUSE_TRAIL)      // Clausal proof trail of additions and deletions.
+	ProofRuleDefinitionIntroduction        = ProofRule(C.Z3_OP_PR_DEF_INTRO)         // Introduces a name for a formula/term.
+	ProofRuleApplyDefinition               = ProofRule(C.Z3_OP_PR_APPLY_DEF)         // Justifies the use of a name introduced by a definition.
+	ProofRuleIFFOEQ                        = ProofRule(C.Z3_OP_PR_IFF_OEQ)           // Given a proof for (iff p q), produces a proof for (~ p q).
+	ProofRuleNNFPositive                   = ProofRule(C.Z3_OP_PR_NNF_POS)           // Proof for a (positive) NNF step.
+	ProofRuleNNFNegative                   = ProofRule(C.Z3_OP_PR_NNF_NEG)           // Proof for a (negative) NNF step.
+	ProofRuleSkolemize                     = ProofRule(C.Z3_OP_PR_SKOLEMIZE)         // Proof for skolemization.
+	ProofRuleModusPonensOEQ                = ProofRule(C.Z3_OP_PR_MODUS_PONENS_OEQ)  // Modus ponens style rule for equi-satisfiability.
+	ProofRuleTheoryLemma                   = ProofRule(C.Z3_OP_PR_TH_LEMMA)          // Generic proof for theory lemmas.
+	ProofRuleHyperResolve                  = ProofRule(C.Z3_OP_PR_HYPER_RESOLVE)     // Hyper-resolution of a clause with a set of unit clauses.
+)
+
+var proofRuleNames = map[ProofRule]string{
+	ProofRuleUndefined:                     "undef",
+	ProofRuleTrue:                          "true",
+	ProofRuleAsserted:                      "asserted",
+	ProofRuleGoal:                          "goal",
+	ProofRuleModusPonens:                   "mp",
+	ProofRuleReflexivity:                   "refl",
+	ProofRuleSymmetry:                      "symm",
+	ProofRuleTransitivity:                  "trans",
+	ProofRuleTransitivityStar:              "trans*",
+	ProofRuleMonotonicity:                  "monotonicity",
+	ProofRuleQuantifierIntroduction:        "quant-intro",
+	ProofRuleBind:                          "proof-bind",
+	ProofRuleDistributivity:                "distributivity",
+	ProofRuleAndElimination:                "and-elim",
+	ProofRuleNotOrElimination:              "not-or-elim",
+	ProofRuleRewrite:                       "rewrite",
+	ProofRuleRewriteStar:                   "rewrite*",
+	ProofRulePullQuantifier:                "pull-quant",
+	ProofRulePushQuantifier:                "push-quant",
+	ProofRuleEliminateUnusedVariables:      "elim-unused",
+	ProofRuleDestructiveEqualityResolution: "der",
+	ProofRuleQuantifierInstantiation:       "quant-inst",
+	ProofRuleHypothesis:                    "hypothesis",
+	ProofRuleLemma:                         "lemma",
+	ProofRuleUnitResolution:                "unit-resolution",
+	ProofRuleIFFTrue:                       "iff-true",
+	ProofRuleIFFFalse:                      "iff-false",
+	ProofRuleCommutativity:                 "commutativity",
+	ProofRuleDefinitionAxiom:               "def-axiom",
+	ProofRuleAssumptionAdd:                 "add-assume",
+	ProofRuleLemmaAdd:                      "add-lemma",
+	ProofRuleRedundantDelete:               "del-redundant",
+	ProofRuleClauseTrail:                   "clause-trail",
+	ProofRuleDefinitionIntroduction:        "intro-def",
+	ProofRuleApplyDefinition:               "apply-def",
+	ProofRuleIFFOEQ:                        "iff~",
+	ProofRuleNNFPositive:                   "nnf-pos",
+	ProofRuleNNFNegative:                   "nnf-neg",
+	ProofRuleSkolemize:                     "sk",
+	ProofRuleModusPonensOEQ:                "mp~",
+	ProofRuleTheoryLemma:                   "th-lemma",
+	ProofRuleHyperResolve:                  "hyper-res",
+}
+
+func (rule ProofRule) String() string {
+	if name, ok := proofRuleNames[rule]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Retrieve the proof for the last Check or CheckAssumptions.
+//
+// The error is an *Error if no proof is available, e.g., because proof generation
+// was not enabled (see Config.SetProof), the commands above were not invoked for
+// the given solver, or the result was different from false.
+func (solver *Solver) Proof() (*AST, error) {
+	return Try(func() *AST {
+		return compute(solver.context, func() *AST {
+			return solver.context.wrapAST(
+				C.Z3_solver_get_proof(solver.context.z3Context, solver.z3Sovler),
+			)
+		}, solver)
+	})
+}
+
+// Return the inference rule of the proof step and whether the AST is a proof step at all.
+func (ast *AST) ProofRule() (rule ProofRule, ok bool) {
+	ast.context.do(func() {
+		z3Context := ast.context.z3Context
+		if !bool(C.Z3_is_app(z3Context, ast.z3AST)) {
+			return
+		}
+
+		kind := C.Z3_get_decl_kind(z3Context, C.Z3_get_app_decl(z3Context, C.Z3_to_app(z3Context, ast.z3AST)))
+		rule = ProofRule(kind)
+		_, ok = proofRuleNames[rule]
+	}, ast)
+	return
+}
+
+// Return the premises of the proof step. That is, the proofs of the facts
+// the conclusion of the step is derived from.
+func (ast *AST) Premises() []*AST {
+	return compute(ast.context, func() []*AST {
+		z3Context := ast.context.z3Context
+		app := C.Z3_to_app(z3Context, ast.z3AST)
+		count := C.Z3_get_app_num_args(z3Context, app)
+		if count == 0 {
+			return nil
+		}
+
+		// The last argument of a proof step is its conclusion.
+		premises := make([]*AST, count-1)
+		for idx := range premises {
+			premises[idx] = ast.context.wrapAST(C.Z3_get_app_arg(z3Context, app, C.uint(idx)))
+		}
+		return premises
+	}, ast)
+}
+
+// Return the fact proven by the proof step.
+func (ast *AST) Conclusion() *AST {
+	return compute(ast.context, func() *AST {
+		z3Context := ast.context.z3Context
+		app := C.Z3_to_app(z3Context, ast.z3AST)
+		count := C.Z3_get_app_num_args(z3Context, app)
+		if count == 0 {
+			return nil
+		}
+		return ast.context.wrapAST(C.Z3_get_app_arg(z3Context, app, count-1))
+	}, ast)
+}
+
+// Walk the proof tree in post-order. That is, the premises of a step are visited
+// before the step itself. Proofs are directed acyclic graphs and steps that are shared
+// between multiple premises are visited once. The walk stops at the first error returned by visit.
+func WalkProof(proof *AST, visit func(step *AST, rule ProofRule) error) error {
+	visited := make(map[uint32]bool)
+
+	var walk func(step *AST) error
+	walk = func(step *AST) error {
+		id := compute(step.context, func() uint32 {
+			return uint32(C.Z3_get_ast_id(step.context.z3Context, step.z3AST))
+		}, step)
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+
+		rule, ok := step.ProofRule()
+		if !ok {
+			return nil
+		}
+
+		for _, premise := range step.Premises() {
+			if err := walk(premise); err != nil {
+				return err
+			}
+		}
+		return visit(step, rule)
+	}
+
+	return walk(proof)
+}
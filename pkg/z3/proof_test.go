@@ -0,0 +1,66 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProof(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	config.SetProof(true)
+	context := NewContext(config)
+	solver := context.NewSolver()
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+	q := context.NewConstant(WithName("q"), context.BooleanSort())
+	solver.Assert(p)
+	solver.Assert(Implies(p, q))
+	solver.Assert(Not(q))
+
+	// Act
+	sat := solver.Check()
+	proof, err := solver.Proof()
+
+	// Assert
+	assert.True(t, sat.IsFalse())
+	assert.NoError(t, err)
+	assert.NotNil(t, proof)
+	assert.Equal(t, "false", proof.Conclusion().String())
+
+	var asserted []string
+	err = WalkProof(proof, func(step *AST, rule ProofRule) error {
+		if rule == ProofRuleAsserted {
+			assert.Empty(t, step.Premises())
+			asserted = append(asserted, step.Conclusion().String())
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"p", "(=> p q)", "(not q)"}, asserted)
+}
+
+func TestProofWithoutProofGeneration(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+
+	// Act
+	solver.Assert(context.NewFalse())
+	solver.Check()
+	proof, err := solver.Proof()
+
+	// Assert
+	var z3Error *Error
+	assert.Nil(t, proof)
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidUsage, z3Error.Code)
+}
+
+func TestProofRuleString(t *testing.T) {
+	assert.Equal(t, "asserted", ProofRuleAsserted.String())
+	assert.Equal(t, "mp", ProofRuleModusPonens.String())
+	assert.Equal(t, "unit-resolution", ProofRuleUnitResolution.String())
+}
@@ -78,3 +78,86 @@ func Distinct(lhs *AST, rhs ...*AST) *AST {
 		}, lhs, rhs...,
 	)
 }
+
+// Create a cardinality constraint that at most k of the Boolean operands are true.
+func AtMost(operands []*AST, k uint) *AST {
+	return cardinality(func(context C.Z3_context, length C.uint, operands *C.Z3_ast) C.Z3_ast {
+		return C.Z3_mk_atmost(context, length, operands, C.uint(k))
+	}, operands)
+}
+
+// Create a cardinality constraint that at least k of the Boolean operands are true.
+func AtLeast(operands []*AST, k uint) *AST {
+	return cardinality(func(context C.Z3_context, length C.uint, operands *C.Z3_ast) C.Z3_ast {
+		return C.Z3_mk_atleast(context, length, operands, C.uint(k))
+	}, operands)
+}
+
+// Create a pseudo-Boolean constraint that the sum of the coefficients of the true operands is at most k,
+// e.g., 2*x + 3*y <= 4 where x and y count as 1 if they are true and as 0 otherwise.
+func PbLe(operands []*AST, coefficients []int32, k int32) *AST {
+	return pseudoBoolean(func(context C.Z3_context, length C.uint, operands *C.Z3_ast, coefficients *C.int) C.Z3_ast {
+		return C.Z3_mk_pble(context, length, operands, coefficients, C.int(k))
+	}, operands, coefficients)
+}
+
+// Create a pseudo-Boolean constraint that the sum of the coefficients of the true operands is at least k.
+func PbGe(operands []*AST, coefficients []int32, k int32) *AST {
+	return pseudoBoolean(func(context C.Z3_context, length C.uint, operands *C.Z3_ast, coefficients *C.int) C.Z3_ast {
+		return C.Z3_mk_pbge(context, length, operands, coefficients, C.int(k))
+	}, operands, coefficients)
+}
+
+// Create a pseudo-Boolean constraint that the sum of the coefficients of the true operands is exactly k.
+func PbEq(operands []*AST, coefficients []int32, k int32) *AST {
+	return pseudoBoolean(func(context C.Z3_context, length C.uint, operands *C.Z3_ast, coefficients *C.int) C.Z3_ast {
+		return C.Z3_mk_pbeq(context, length, operands, coefficients, C.int(k))
+	}, operands, coefficients)
+}
+
+func cardinality(
+	operation func(context C.Z3_context, length C.uint, operands *C.Z3_ast) C.Z3_ast,
+	operands []*AST,
+) *AST {
+	if len(operands) == 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "cardinality constraints need at least one operand"})
+	}
+
+	context := operands[0].context
+	args := make([]C.Z3_ast, len(operands))
+	for idx := range operands {
+		args[idx] = operands[idx].z3AST
+	}
+
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			operation(context.z3Context, C.uint(len(args)), pointerTo(args)),
+		)
+	}, operands)
+}
+
+func pseudoBoolean(
+	operation func(context C.Z3_context, length C.uint, operands *C.Z3_ast, coefficients *C.int) C.Z3_ast,
+	operands []*AST, coefficients []int32,
+) *AST {
+	if len(operands) == 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "pseudo-Boolean constraints need at least one operand"})
+	}
+	if len(operands) != len(coefficients) {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "pseudo-Boolean operands and coefficients must have the same length"})
+	}
+
+	context := operands[0].context
+	args := make([]C.Z3_ast, len(operands))
+	z3Coefficients := make([]C.int, len(coefficients))
+	for idx := range operands {
+		args[idx] = operands[idx].z3AST
+		z3Coefficients[idx] = C.int(coefficients[idx])
+	}
+
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			operation(context.z3Context, C.uint(len(args)), pointerTo(args), pointerTo(z3Coefficients)),
+		)
+	}, operands)
+}
@@ -0,0 +1,80 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBooleans(context *Context, names ...string) []*AST {
+	booleans := make([]*AST, len(names))
+	for idx, name := range names {
+		booleans[idx] = context.NewConstant(WithName(name), context.BooleanSort())
+	}
+	return booleans
+}
+
+func TestAtMost(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	solver := context.NewSolver()
+	booleans := newBooleans(context, "a", "b", "c")
+
+	// Act
+	solver.Assert(AtMost(booleans, 1))
+
+	// Assert
+	assert.True(t, solver.HasSolutionFor(booleans[0]))
+	assert.False(t, solver.HasSolutionFor(And(booleans[0], booleans[2])))
+	assert.Equal(t, DeclKindPbAtMost, AtMost(booleans, 1).Decl().Kind())
+}
+
+func TestAtLeast(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	solver := context.NewSolver()
+	booleans := newBooleans(context, "a", "b", "c")
+
+	// Act
+	solver.Assert(AtLeast(booleans, 2))
+
+	// Assert
+	assert.True(t, solver.Proven(Or(booleans[0], booleans[1])))
+	assert.False(t, solver.HasSolutionFor(Not(Or(booleans[1], booleans[2]))))
+}
+
+func TestPseudoBoolean(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	solver := context.NewSolver()
+	booleans := newBooleans(context, "a", "b", "c")
+	coefficients := []int32{2, 3, 4}
+
+	// Act
+	solver.Assert(PbLe(booleans, coefficients, 6))
+	solver.Assert(PbGe(booleans, coefficients, 5))
+
+	// Assert
+	assert.True(t, solver.Proven(booleans[0]))
+	assert.False(t, solver.HasSolutionFor(And(booleans[1], booleans[2])))
+	assert.True(t, solver.HasSolutionFor(PbEq(booleans, coefficients, 5)))
+	assert.False(t, solver.HasSolutionFor(PbEq(booleans, coefficients, 4)))
+}
+
+func TestPseudoBooleanLengthMismatch(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	booleans := newBooleans(context, "a", "b")
+
+	// Act
+	_, mismatch := Try(func() *AST { return PbLe(booleans, []int32{1}, 1) })
+	_, empty := Try(func() *AST { return AtMost(nil, 1) })
+
+	// Assert
+	var z3Error *Error
+	assert.True(t, errors.As(mismatch, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+	assert.True(t, errors.As(empty, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+}
@@ -0,0 +1,371 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+
+// A pattern is used to guide quantifier instantiation.
+type Pattern struct {
+	// The AST of the pattern keeps the pattern alive as patterns are reference counted like ASTs.
+	ast       *AST
+	z3Pattern C.Z3_pattern
+}
+
+// Create a pattern for quantifier instantiation.
+//
+// Z3 uses pattern matching to instantiate quantifiers. If a pattern is not provided for a
+// quantifier, then Z3 will automatically compute a set of patterns for it. However, for
+// optimal performance, the user should provide the patterns.
+//
+// Patterns comprise a list of terms. The list should be non-empty. If the list comprises
+// of more than one term, it is a called a multi-pattern.
+//
+// In general, one can pass in a list of (multi-)patterns in the quantifier constructor.
+func NewPattern(term *AST, terms ...*AST) *Pattern {
+	context := term.context
+	args := make([]C.Z3_ast, len(terms)+1)
+	args[0] = term.z3AST
+	for idx := range terms {
+		args[idx+1] = terms[idx].z3AST
+	}
+
+	return compute(context, func() *Pattern {
+		z3Pattern := C.Z3_mk_pattern(context.z3Context, C.uint(len(args)), &args[0])
+		context.check()
+		return &Pattern{
+			ast:       context.wrapAST(C.Z3_pattern_to_ast(context.z3Context, z3Pattern)),
+			z3Pattern: z3Pattern,
+		}
+	}, term, terms)
+}
+
+func (pattern *Pattern) AST() *AST {
+	return pattern.ast
+}
+
+func (pattern *Pattern) String() string {
+	return pattern.ast.String()
+}
+
+// Additional attributes of a quantifier.
+type quantifierOptions struct {
+	weight     uint
+	id         SymbolFactory
+	skolemID   SymbolFactory
+	patterns   []*Pattern
+	noPatterns []*AST
+}
+
+// QuantifierOption sets an attribute of a quantifier.
+type QuantifierOption func(options *quantifierOptions)
+
+// Set the weight of the quantifier. Quantifiers with higher weights are instantiated less
+// often. The default weight is zero.
+func WithWeight(weight uint) QuantifierOption {
+	return func(options *quantifierOptions) {
+		options.weight = weight
+	}
+}
+
+// Set the identifier of the quantifier. It is used for debugging and profiling.
+func WithQuantifierID(id SymbolFactory) QuantifierOption {
+	return func(options *quantifierOptions) {
+		options.id = id
+	}
+}
+
+// Set the prefix of the skolem constants introduced for the quantifier.
+func WithSkolemID(id SymbolFactory) QuantifierOption {
+	return func(options *quantifierOptions) {
+		options.skolemID = id
+	}
+}
+
+// Add (multi-)patterns guiding the instantiation of the quantifier.
+func WithPatterns(patterns ...*Pattern) QuantifierOption {
+	return func(options *quantifierOptions) {
+		options.patterns = append(options.patterns, patterns...)
+	}
+}
+
+// Add terms that must not be used as patterns of the quantifier.
+func WithNoPatterns(terms ...*AST) QuantifierOption {
+	return func(options *quantifierOptions) {
+		options.noPatterns = append(options.noPatterns, terms...)
+	}
+}
+
+// The Z3 representation of the quantifier options. The symbols are created eagerly as the
+// symbol factories acquire the context lock themselves.
+type z3QuantifierOptions struct {
+	weight     C.uint
+	id         C.Z3_symbol
+	skolemID   C.Z3_symbol
+	patterns   []C.Z3_pattern
+	noPatterns []C.Z3_ast
+}
+
+func newQuantifierOptions(context *Context, options []QuantifierOption) (z3Options z3QuantifierOptions, keeps []any) {
+	var quantifier quantifierOptions
+	for _, option := range options {
+		option(&quantifier)
+	}
+
+	z3Options.weight = C.uint(quantifier.weight)
+	if quantifier.id != nil {
+		z3Options.id = quantifier.id(context).z3Symbol
+	}
+	if quantifier.skolemID != nil {
+		z3Options.skolemID = quantifier.skolemID(context).z3Symbol
+	}
+	z3Options.patterns = make([]C.Z3_pattern, len(quantifier.patterns))
+	for idx := range quantifier.patterns {
+		z3Options.patterns[idx] = quantifier.patterns[idx].z3Pattern
+	}
+	z3Options.noPatterns = make([]C.Z3_ast, len(quantifier.noPatterns))
+	for idx := range quantifier.noPatterns {
+		z3Options.noPatterns[idx] = quantifier.noPatterns[idx].z3AST
+	}
+
+	return z3Options, []any{quantifier.patterns, quantifier.noPatterns}
+}
+
+func quantifierConst(forall bool, bound []*AST, body *AST, options []QuantifierOption) *AST {
+	context := body.context
+	z3Options, keeps := newQuantifierOptions(context, options)
+
+	return compute(context, func() *AST {
+		z3Bound := make([]C.Z3_app, len(bound))
+		for idx := range bound {
+			z3Bound[idx] = C.Z3_to_app(context.z3Context, bound[idx].z3AST)
+		}
+
+		return context.wrapAST(
+			C.Z3_mk_quantifier_const_ex(
+				context.z3Context,
+				C.bool(forall),
+				z3Options.weight,
+				z3Options.id,
+				z3Options.skolemID,
+				C.uint(len(z3Bound)), pointerTo(z3Bound),
+				C.uint(len(z3Options.patterns)), pointerTo(z3Options.patterns),
+				C.uint(len(z3Options.noPatterns)), pointerTo(z3Options.noPatterns),
+				body.z3AST,
+			),
+		)
+	}, bound, body, keeps)
+}
+
+func quantifierBound(forall bool, sorts []*Sort, names []SymbolFactory, body *AST, options []QuantifierOption) *AST {
+	if len(sorts) != len(names) {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "quantifier sorts and names must have the same length"})
+	}
+
+	context := body.context
+	z3Options, keeps := newQuantifierOptions(context, options)
+	z3Names := make([]C.Z3_symbol, len(names))
+	for idx := range names {
+		z3Names[idx] = names[idx](context).z3Symbol
+	}
+
+	return compute(context, func() *AST {
+		z3Sorts := make([]C.Z3_sort, len(sorts))
+		for idx := range sorts {
+			z3Sorts[idx] = sorts[idx].z3Sort
+		}
+
+		return context.wrapAST(
+			C.Z3_mk_quantifier_ex(
+				context.z3Context,
+				C.bool(forall),
+				z3Options.weight,
+				z3Options.id,
+				z3Options.skolemID,
+				C.uint(len(z3Options.patterns)), pointerTo(z3Options.patterns),
+				C.uint(len(z3Options.noPatterns)), pointerTo(z3Options.noPatterns),
+				C.uint(len(z3Sorts)), pointerTo(z3Sorts),
+				pointerTo(z3Names),
+				body.z3AST,
+			),
+		)
+	}, sorts, body, keeps)
+}
+
+// Create a universal quantifier over the given constants.
+//
+// The bound constants are abstracted from the body, that is, the quantifier binds every
+// occurrence of them in the body. The constants must have been created with NewConstant.
+func ForAll(bound []*AST, body *AST, options ...QuantifierOption) *AST {
+	return quantifierConst(true, bound, body, options)
+}
+
+// Create an existential quantifier over the given constants.
+//
+// The bound constants are abstracted from the body, that is, the quantifier binds every
+// occurrence of them in the body. The constants must have been created with NewConstant.
+func Exists(bound []*AST, body *AST, options ...QuantifierOption) *AST {
+	return quantifierConst(false, bound, body, options)
+}
+
+// Create a universal quantifier using de Bruijn indices.
+//
+// The body refers to the bound variables using NewBound. The variable with the
+// de Bruijn index i is the variable of sorts[n-i-1] and names[n-i-1], where n is
+// the number of bound variables. Panics with an *Error if the number of sorts and names differ.
+func ForAllBound(sorts []*Sort, names []SymbolFactory, body *AST, options ...QuantifierOption) *AST {
+	return quantifierBound(true, sorts, names, body, options)
+}
+
+// Create an existential quantifier using de Bruijn indices.
+//
+// The body refers to the bound variables using NewBound. The variable with the
+// de Bruijn index i is the variable of sorts[n-i-1] and names[n-i-1], where n is
+// the number of bound variables. Panics with an *Error if the number of sorts and names differ.
+func ExistsBound(sorts []*Sort, names []SymbolFactory, body *AST, options ...QuantifierOption) *AST {
+	return quantifierBound(false, sorts, names, body, options)
+}
+
+// Create a lambda expression over the given constants.
+//
+// The sort of the result is an array whose domain are the sorts of the bound
+// constants and whose range is the sort of the body.
+func Lambda(bound []*AST, body *AST) *AST {
+	context := body.context
+	return compute(context, func() *AST {
+		z3Bound := make([]C.Z3_app, len(bound))
+		for idx := range bound {
+			z3Bound[idx] = C.Z3_to_app(context.z3Context, bound[idx].z3AST)
+		}
+
+		return context.wrapAST(
+			C.Z3_mk_lambda_const(
+				context.z3Context,
+				C.uint(len(z3Bound)), pointerTo(z3Bound),
+				body.z3AST,
+			),
+		)
+	}, bound, body)
+}
+
+// Create a lambda expression using de Bruijn indices.
+//
+// The body refers to the bound variables using NewBound, following the same
+// convention as ForAllBound. Panics with an *Error if the number of sorts and names differ.
+func LambdaBound(sorts []*Sort, names []SymbolFactory, body *AST) *AST {
+	if len(sorts) != len(names) {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "lambda sorts and names must have the same length"})
+	}
+
+	context := body.context
+	z3Names := make([]C.Z3_symbol, len(names))
+	for idx := range names {
+		z3Names[idx] = names[idx](context).z3Symbol
+	}
+
+	return compute(context, func() *AST {
+		z3Sorts := make([]C.Z3_sort, len(sorts))
+		for idx := range sorts {
+			z3Sorts[idx] = sorts[idx].z3Sort
+		}
+
+		return context.wrapAST(
+			C.Z3_mk_lambda(
+				context.z3Context,
+				C.uint(len(z3Sorts)), pointerTo(z3Sorts),
+				pointerTo(z3Names),
+				body.z3AST,
+			),
+		)
+	}, sorts, body)
+}
+
+// Return true if the AST is a universal quantifier.
+func (ast *AST) IsForAll() bool {
+	return compute(ast.context, func() bool {
+		return ast.isQuantifier() && bool(C.Z3_is_quantifier_forall(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return true if the AST is an existential quantifier.
+func (ast *AST) IsExists() bool {
+	return compute(ast.context, func() bool {
+		return ast.isQuantifier() && bool(C.Z3_is_quantifier_exists(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return true if the AST is a lambda expression.
+func (ast *AST) IsLambda() bool {
+	return compute(ast.context, func() bool {
+		return ast.isQuantifier() && bool(C.Z3_is_lambda(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+func (ast *AST) isQuantifier() bool {
+	return C.Z3_get_ast_kind(ast.context.z3Context, ast.z3AST) == C.Z3_QUANTIFIER_AST
+}
+
+// Return the body of the quantifier or lambda. The bound variables occur in the body as de Bruijn indices,
+// i.e., the variable at position i of the bound variables is the variable with index n-i-1 (see VarIndex),
+// where n is the number of bound variables.
+// Panics with an *Error if the AST is not a quantifier.
+func (ast *AST) Body() *AST {
+	return compute(ast.context, func() *AST {
+		return ast.context.wrapAST(
+			C.Z3_get_quantifier_body(ast.context.z3Context, ast.z3AST),
+		)
+	}, ast)
+}
+
+// Return the number of variables bound by the quantifier or lambda.
+// Panics with an *Error if the AST is not a quantifier.
+func (ast *AST) NumBound() uint {
+	return compute(ast.context, func() uint {
+		return uint(C.Z3_get_quantifier_num_bound(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return the name of the bound variable at position idx of the quantifier or lambda.
+func (ast *AST) BoundName(idx uint) Symbol {
+	return compute(ast.context, func() Symbol {
+		return Symbol{
+			context:  ast.context,
+			z3Symbol: C.Z3_get_quantifier_bound_name(ast.context.z3Context, ast.z3AST, C.uint(idx)),
+		}
+	}, ast)
+}
+
+// Return the sort of the bound variable at position idx of the quantifier or lambda.
+func (ast *AST) BoundSort(idx uint) *Sort {
+	return compute(ast.context, func() *Sort {
+		return ast.context.wrapSort(
+			C.Z3_get_quantifier_bound_sort(ast.context.z3Context, ast.z3AST, C.uint(idx)),
+		)
+	}, ast)
+}
+
+// Return the weight of the quantifier, see WithWeight.
+func (ast *AST) Weight() uint {
+	return compute(ast.context, func() uint {
+		return uint(C.Z3_get_quantifier_weight(ast.context.z3Context, ast.z3AST))
+	}, ast)
+}
+
+// Return the patterns of the quantifier, see WithPatterns.
+func (ast *AST) Patterns() []*Pattern {
+	context := ast.context
+	return compute(context, func() []*Pattern {
+		patterns := make([]*Pattern, C.Z3_get_quantifier_num_patterns(context.z3Context, ast.z3AST))
+		for idx := range patterns {
+			z3Pattern := C.Z3_get_quantifier_pattern_ast(context.z3Context, ast.z3AST, C.uint(idx))
+			context.check()
+			patterns[idx] = &Pattern{
+				ast:       context.wrapAST(C.Z3_pattern_to_ast(context.z3Context, z3Pattern)),
+				z3Pattern: z3Pattern,
+			}
+		}
+		return patterns
+	}, ast)
+}
@@ -0,0 +1,158 @@
+package z3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForAll(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	f := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer}, integer)
+	x := context.NewConstant(WithName("x"), integer)
+	zero := context.NewInt(0, integer)
+	fx := f.Application([]*AST{x})
+
+	// Act
+	axiom := ForAll(
+		[]*AST{x}, GT(fx, zero),
+		WithPatterns(NewPattern(fx)), WithQuantifierID(WithName("positive")), WithWeight(5),
+	)
+	solver.Assert(axiom)
+
+	// Assert
+	// The order of the attributes in the printed form differs between Z3 versions.
+	assert.Contains(t, axiom.String(), ":qid positive")
+	assert.Equal(t, uint(5), axiom.Weight())
+	assert.Len(t, axiom.Patterns(), 1)
+	assert.Equal(t, "((f (:var 0)))", axiom.Patterns()[0].String())
+	assert.True(t, solver.Proven(GT(f.Application([]*AST{context.NewInt(1, integer)}), zero)))
+}
+
+func TestExists(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+
+	// Act
+	existence := Exists([]*AST{x}, Eq(Multiply(x, x), context.NewInt(4, integer)))
+
+	// Assert
+	assert.True(t, solver.Proven(existence))
+}
+
+func TestForAllBound(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	f := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer, integer}, integer)
+	x := context.NewBound(1, integer)
+	y := context.NewBound(0, integer)
+	body := Eq(f.Application([]*AST{x, y}), f.Application([]*AST{y, x}))
+
+	// Act
+	commutative := ForAllBound(
+		[]*Sort{integer, integer}, []SymbolFactory{WithName("x"), WithName("y")}, body,
+		WithNoPatterns(f.Application([]*AST{x, y})),
+	)
+	solver.Assert(commutative)
+	a := context.NewConstant(WithName("a"), integer)
+	b := context.NewConstant(WithName("b"), integer)
+
+	// Assert
+	assert.True(t, solver.Proven(Eq(f.Application([]*AST{a, b}), f.Application([]*AST{b, a}))))
+	assert.Equal(t, "(= (f a b) (f b a))", body.SubstituteVariables([]*AST{b, a}).String())
+}
+
+func TestLambda(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	one := context.NewInt(1, integer)
+
+	// Act
+	successor := Lambda([]*AST{x}, Add(x, one))
+	predecessor := LambdaBound([]*Sort{integer}, []SymbolFactory{WithName("y")}, Subtract(context.NewBound(0, integer), one))
+
+	// Assert
+	assert.Equal(t, KindArray, successor.Sort().Kind())
+	assert.True(t, solver.Proven(Eq(Select(predecessor, Select(successor, x)), x)))
+}
+
+func TestQuantifierIntrospection(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	f := context.NewFunctionDeclaration(WithName("f"), []*Sort{integer}, integer)
+	x := context.NewConstant(WithName("x"), integer)
+	fx := f.Application([]*AST{x})
+	axiom := ForAll([]*AST{x}, GT(fx, context.NewInt(0, integer)), WithPatterns(NewPattern(fx)), WithWeight(3))
+
+	// Act
+	body := axiom.Body()
+	bound := body.Arg(0).Arg(0)
+
+	// Assert
+	assert.True(t, axiom.IsQuantifier())
+	assert.True(t, axiom.IsForAll())
+	assert.False(t, axiom.IsExists())
+	assert.False(t, axiom.IsLambda())
+	assert.False(t, x.IsForAll())
+	assert.Equal(t, uint(1), axiom.NumBound())
+	assert.Equal(t, "x", axiom.BoundName(0).String())
+	assert.Equal(t, KindInt, axiom.BoundSort(0).Kind())
+	assert.Equal(t, uint(3), axiom.Weight())
+	assert.Len(t, axiom.Patterns(), 1)
+	assert.Equal(t, DeclKindGT, body.Decl().Kind())
+	assert.True(t, bound.IsVar())
+	assert.Equal(t, uint(0), bound.VarIndex())
+	assert.True(t, Lambda([]*AST{x}, fx).IsLambda())
+}
+
+func TestInvalidPattern(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+
+	// Act
+	pattern, err := Try(func() *Pattern { return NewPattern(context.NewBound(0, integer)) })
+
+	// Assert
+	var z3Error *Error
+	assert.Nil(t, pattern)
+	assert.True(t, errors.As(err, &z3Error))
+}
+
+func TestBoundMismatchedNames(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	body := GT(context.NewBound(0, integer), context.NewInt(0, integer))
+
+	// Act
+	_, forall := Try(func() *AST { return ForAllBound([]*Sort{integer}, nil, body) })
+	_, lambda := Try(func() *AST { return LambdaBound([]*Sort{integer}, nil, body) })
+
+	// Assert
+	var z3Error *Error
+	assert.True(t, errors.As(forall, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+	assert.True(t, errors.As(lambda, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+}
@@ -0,0 +1,325 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+import (
+	"fmt"
+	"regexp/syntax"
+	"unicode"
+)
+
+// Create a regular expression sort out of a sequence sort.
+func (context *Context) RegularExpressionSort(sequence *Sort) *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_re_sort(context.z3Context, sequence.z3Sort),
+		)
+	}, sequence)
+}
+
+// Create an empty regular expression of the given regular expression sort.
+func (context *Context) NewEmptyRe(sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_re_empty(context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create an universal regular expression of the given regular expression sort.
+func (context *Context) NewFullRe(sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_re_full(context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a regular expression that accepts all singleton sequences of the given regular expression sort.
+func (context *Context) NewAllCharRe(sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_re_allchar(context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a regular expression that accepts the sequence.
+func SeqToRe(sequence *AST) *AST {
+	return unary(
+		func(context C.Z3_context, sequence C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_to_re(context, sequence)
+		}, sequence,
+	)
+}
+
+// Check if sequence is in the language generated by the regular expression.
+func InRe(sequence, re *AST) *AST {
+	return binary(
+		func(context C.Z3_context, sequence, re C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_in_re(context, sequence, re)
+		}, sequence, re,
+	)
+}
+
+// Create the regular language re+.
+func RePlus(re *AST) *AST {
+	return unary(
+		func(context C.Z3_context, re C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_plus(context, re)
+		}, re,
+	)
+}
+
+// Create the regular language re*.
+func ReStar(re *AST) *AST {
+	return unary(
+		func(context C.Z3_context, re C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_star(context, re)
+		}, re,
+	)
+}
+
+// Create the regular language [re].
+func ReOption(re *AST) *AST {
+	return unary(
+		func(context C.Z3_context, re C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_option(context, re)
+		}, re,
+	)
+}
+
+// Create the union of the regular languages.
+func ReUnion(lhs *AST, rhs ...*AST) *AST {
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_union(context, length, &operands[0])
+		}, lhs, rhs...,
+	)
+}
+
+// Create the concatenation of the regular languages.
+func ReConcat(lhs *AST, rhs ...*AST) *AST {
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_concat(context, length, &operands[0])
+		}, lhs, rhs...,
+	)
+}
+
+// Create the intersection of the regular languages.
+func ReIntersect(lhs *AST, rhs ...*AST) *AST {
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_intersect(context, length, &operands[0])
+		}, lhs, rhs...,
+	)
+}
+
+// Create the complement of the regular language.
+func ReComplement(re *AST) *AST {
+	return unary(
+		func(context C.Z3_context, re C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_complement(context, re)
+		}, re,
+	)
+}
+
+// Create the range regular expression over two sequences of length 1.
+func ReRange(low, high *AST) *AST {
+	return binary(
+		func(context C.Z3_context, low, high C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_range(context, low, high)
+		}, low, high,
+	)
+}
+
+// Create a regular expression loop. The supplied regular expression is repeated
+// between low and high times. The low should be below high with one exception: when
+// supplying the value high as 0, the meaning is to repeat the argument at least
+// low number of times, and with an unbounded upper bound.
+func ReLoop(re *AST, low, high uint) *AST {
+	return unary(
+		func(context C.Z3_context, re C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_re_loop(context, re, C.uint(low), C.uint(high))
+		}, re,
+	)
+}
+
+// The largest character of the default "unicode" string encoding.
+// Character classes of Go regular expressions are clamped to this character.
+const maxCharacter = 0x2FFFF
+
+// Compile the Go regular expression into a regular expression over strings.
+//
+// Contrary to the Go regexp package, the resulting regular expression matches
+// entire strings. A leading ^ and a trailing $ are therefore redundant and ignored,
+// also at the boundaries of alternatives such as ^a$|^b$.
+// Other anchors and word boundaries are not supported and result in an error.
+func (context *Context) CompileRegexp(re *syntax.Regexp) (*AST, error) {
+	compiler := regexpCompiler{
+		context: context,
+		sort:    context.RegularExpressionSort(context.StringSort()),
+	}
+	return compiler.compile(stripAnchors(re, true, true))
+}
+
+// Remove the begin anchors at the start of the regular expression if begin is set,
+// and the end anchors at its end if end is set. The given regular expression is not modified.
+func stripAnchors(re *syntax.Regexp, begin, end bool) *syntax.Regexp {
+	switch {
+	case re.Op == syntax.OpBeginText && begin, re.Op == syntax.OpEndText && end:
+		return &syntax.Regexp{Op: syntax.OpEmptyMatch, Flags: re.Flags}
+	case re.Op == syntax.OpConcat && len(re.Sub) > 0:
+		last := len(re.Sub) - 1
+		subs := make([]*syntax.Regexp, len(re.Sub))
+		copy(subs, re.Sub)
+		subs[0] = stripAnchors(subs[0], begin, end && last == 0)
+		if last > 0 {
+			subs[last] = stripAnchors(subs[last], false, end)
+		}
+		return &syntax.Regexp{Op: re.Op, Flags: re.Flags, Sub: subs}
+	case re.Op == syntax.OpAlternate, re.Op == syntax.OpCapture:
+		subs := make([]*syntax.Regexp, len(re.Sub))
+		for idx := range re.Sub {
+			subs[idx] = stripAnchors(re.Sub[idx], begin, end)
+		}
+		stripped := *re
+		stripped.Sub = subs
+		return &stripped
+	}
+	return re
+}
+
+// Parse the pattern using the Perl syntax of the Go regexp package and compile it into
+// a regular expression over strings. See CompileRegexp for the supported syntax.
+func (context *Context) NewRegexp(pattern string) (*AST, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	return context.CompileRegexp(re.Simplify())
+}
+
+type regexpCompiler struct {
+	context *Context
+	sort    *Sort
+}
+
+func (compiler *regexpCompiler) compile(re *syntax.Regexp) (*AST, error) {
+	context := compiler.context
+
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return context.NewEmptyRe(compiler.sort), nil
+	case syntax.OpEmptyMatch:
+		return SeqToRe(context.NewString("")), nil
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase == 0 {
+			return SeqToRe(context.NewString(string(re.Rune))), nil
+		}
+		characters := make([]*AST, len(re.Rune))
+		for idx, character := range re.Rune {
+			characters[idx] = compiler.foldCase(character)
+		}
+		return compiler.concat(characters), nil
+	case syntax.OpCharClass:
+		return compiler.class(re.Rune), nil
+	case syntax.OpAnyCharNotNL:
+		return compiler.class([]rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune}), nil
+	case syntax.OpAnyChar:
+		return context.NewAllCharRe(compiler.sort), nil
+	case syntax.OpCapture:
+		return compiler.compile(re.Sub[0])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest:
+		sub, err := compiler.compile(re.Sub[0])
+		if err != nil {
+			return nil, err
+		}
+		switch re.Op {
+		case syntax.OpStar:
+			return ReStar(sub), nil
+		case syntax.OpPlus:
+			return RePlus(sub), nil
+		default:
+			return ReOption(sub), nil
+		}
+	case syntax.OpRepeat:
+		sub, err := compiler.compile(re.Sub[0])
+		if err != nil {
+			return nil, err
+		}
+		if re.Max == 0 {
+			return SeqToRe(context.NewString("")), nil
+		}
+		if re.Max < 0 {
+			// An upper bound of zero denotes an unbounded loop.
+			return ReLoop(sub, uint(re.Min), 0), nil
+		}
+		return ReLoop(sub, uint(re.Min), uint(re.Max)), nil
+	case syntax.OpConcat, syntax.OpAlternate:
+		subs := make([]*AST, len(re.Sub))
+		for idx := range re.Sub {
+			sub, err := compiler.compile(re.Sub[idx])
+			if err != nil {
+				return nil, err
+			}
+			subs[idx] = sub
+		}
+		if re.Op == syntax.OpConcat {
+			return compiler.concat(subs), nil
+		}
+		return compiler.union(subs), nil
+	}
+
+	return nil, fmt.Errorf("unsupported regular expression operator %s in %s", re.Op, re)
+}
+
+// Create the union of the character and its case-folding equivalents.
+func (compiler *regexpCompiler) foldCase(character rune) *AST {
+	characters := []*AST{SeqToRe(compiler.context.NewString(string(character)))}
+	for folded := unicode.SimpleFold(character); folded != character; folded = unicode.SimpleFold(folded) {
+		characters = append(characters, SeqToRe(compiler.context.NewString(string(folded))))
+	}
+	return compiler.union(characters)
+}
+
+// Create the union of the character ranges given as pairs of inclusive bounds.
+func (compiler *regexpCompiler) class(ranges []rune) *AST {
+	var alternatives []*AST
+	for idx := 0; idx+1 < len(ranges); idx += 2 {
+		low, high := ranges[idx], min(ranges[idx+1], maxCharacter)
+		if low > high {
+			continue
+		}
+		alternatives = append(alternatives, ReRange(
+			compiler.context.NewString(string(low)),
+			compiler.context.NewString(string(high)),
+		))
+	}
+	return compiler.union(alternatives)
+}
+
+func (compiler *regexpCompiler) union(alternatives []*AST) *AST {
+	if len(alternatives) == 0 {
+		return compiler.context.NewEmptyRe(compiler.sort)
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0]
+	}
+	return ReUnion(alternatives[0], alternatives[1:]...)
+}
+
+func (compiler *regexpCompiler) concat(sequence []*AST) *AST {
+	if len(sequence) == 0 {
+		return SeqToRe(compiler.context.NewString(""))
+	}
+	if len(sequence) == 1 {
+		return sequence[0]
+	}
+	return ReConcat(sequence[0], sequence[1:]...)
+}
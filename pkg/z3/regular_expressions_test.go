@@ -0,0 +1,114 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegularExpressionMembership(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	digit := ReRange(context.NewString("0"), context.NewString("9"))
+	number := ReConcat(ReOption(SeqToRe(context.NewString("-"))), RePlus(digit))
+
+	// Act
+	accepted := solver.Proven(InRe(context.NewString("-42"), number))
+	rejected := solver.Proven(Not(InRe(context.NewString("4-2"), number)))
+
+	// Assert
+	assert.True(t, accepted)
+	assert.True(t, rejected)
+	assert.Equal(t, KindRegularExpression, number.Sort().Kind())
+}
+
+func TestRegularExpressionLoop(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	sort := context.RegularExpressionSort(context.StringSort())
+	s := context.NewConstant(WithName("s"), context.StringSort())
+	re := ReLoop(context.NewAllCharRe(sort), 2, 3)
+
+	// Act
+	proven := solver.Proven(Implies(InRe(s, re), And(
+		LE(context.NewInt(2, context.IntegerSort()), SeqLength(s)),
+		LE(SeqLength(s), context.NewInt(3, context.IntegerSort())),
+	)))
+
+	// Assert
+	assert.True(t, proven)
+	assert.True(t, solver.Proven(InRe(s, context.NewFullRe(sort))))
+	assert.True(t, solver.Proven(Not(InRe(s, ReIntersect(re, ReComplement(re))))))
+	assert.True(t, solver.Proven(Not(InRe(s, context.NewEmptyRe(sort)))))
+}
+
+func TestNewRegexp(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		input    string
+		accepted bool
+	}{
+		{pattern: `^[a-z]+@[a-z]+\.com$`, input: "user@example.com", accepted: true},
+		{pattern: `^[a-z]+@[a-z]+\.com$`, input: "User@example.com", accepted: false},
+		{pattern: `(?i)hello`, input: "HeLLo", accepted: true},
+		{pattern: `a{2,3}`, input: "aaaa", accepted: false},
+		{pattern: `a{2,}`, input: "aaaa", accepted: true},
+		{pattern: `[^0-9]*`, input: "abc", accepted: true},
+		{pattern: `[^0-9]*`, input: "a1c", accepted: false},
+		{pattern: `.`, input: "\n", accepted: false},
+		{pattern: `(?s).`, input: "\n", accepted: true},
+		{pattern: `x|y(z)?`, input: "yz", accepted: true},
+		{pattern: `^a$|^b$`, input: "b", accepted: true},
+		{pattern: `^a$|^b$`, input: "ab", accepted: false},
+		{pattern: `^(?:yes|no)$|^(maybe)$`, input: "maybe", accepted: true},
+		{pattern: `^$`, input: "", accepted: true},
+	}
+
+	for _, test := range tests {
+		// Arrange
+		config := NewConfig()
+		context := NewContext(config)
+		solver := context.NewSolver()
+
+		// Act
+		re, err := context.NewRegexp(test.pattern)
+
+		// Assert
+		assert.NoError(t, err, test.pattern)
+		assert.Equal(t, test.accepted, solver.Proven(InRe(context.NewString(test.input), re)), test.pattern)
+	}
+}
+
+func TestNewRegexpOverlap(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	s := context.NewConstant(WithName("s"), context.StringSort())
+	identifier, _ := context.NewRegexp(`[a-z][a-z0-9]*`)
+	number, _ := context.NewRegexp(`[0-9]+`)
+
+	// Act
+	overlap := solver.HasSolutionFor(And(InRe(s, identifier), InRe(s, number)))
+
+	// Assert
+	assert.False(t, overlap)
+}
+
+func TestNewRegexpUnsupported(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	_, boundary := context.NewRegexp(`\bword\b`)
+	_, innerAnchor := context.NewRegexp(`a^b`)
+
+	// Assert
+	assert.Error(t, boundary)
+	assert.Error(t, innerAnchor)
+}
@@ -0,0 +1,278 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Create a sequence sort out of the sort for the elements.
+func (context *Context) SequenceSort(element *Sort) *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_seq_sort(context.z3Context, element.z3Sort),
+		)
+	}, element)
+}
+
+// Create a sort for unicode strings.
+//
+// The sort for characters can be changed to ASCII by setting
+// the global parameter encoding to ascii, or alternative to 16 bit
+// characters by setting encoding to bmp.
+func (context *Context) StringSort() *Sort {
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_string_sort(context.z3Context),
+		)
+	})
+}
+
+// Check if the sort is a string sort.
+func (sort *Sort) IsString() bool {
+	return compute(sort.context, func() bool {
+		return bool(C.Z3_is_string_sort(sort.context.z3Context, sort.z3Sort))
+	}, sort)
+}
+
+// Retrieve basis sort for a sequence sort.
+func (sort *Sort) SequenceBasis() *Sort {
+	return compute(sort.context, func() *Sort {
+		return sort.context.wrapSort(
+			C.Z3_get_seq_sort_basis(sort.context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a string constant out of the given Go string.
+//
+// Every character that is not printable ASCII is passed to Z3 as an escaped
+// code point (\u{...}). Hence, the string is independent of the escape sequences
+// understood by the SMT-LIB parser. Note that code points beyond the range of the
+// configured encoding ("unicode", "bmp" or "ascii") are rejected by Z3.
+func (context *Context) NewString(value string) *AST {
+	// Allocate an unmanged string and make sure it is freed.
+	cValue := C.CString(escapeString(value))
+	defer C.free(unsafe.Pointer(cValue))
+
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_string(context.z3Context, cValue),
+		)
+	})
+}
+
+// Escape all non-printable ASCII characters and backslashes of the given string
+// using the code point escape sequence understood by Z3.
+func escapeString(value string) string {
+	var builder strings.Builder
+	for _, character := range value {
+		if character >= 0x20 && character < 0x7f && character != '\\' {
+			builder.WriteRune(character)
+		} else {
+			fmt.Fprintf(&builder, "\\u{%x}", character)
+		}
+	}
+	return builder.String()
+}
+
+// Revert the escaping of Z3 string constants. That is, replace the code point
+// escape sequences \u{...} by the corresponding characters.
+func unescapeString(value string) string {
+	var builder strings.Builder
+	for len(value) > 0 {
+		if strings.HasPrefix(value, `\u{`) {
+			if end := strings.IndexByte(value, '}'); end > 0 {
+				if code, err := strconv.ParseUint(value[3:end], 16, 32); err == nil {
+					builder.WriteRune(rune(code))
+					value = value[end+1:]
+					continue
+				}
+			}
+		}
+		builder.WriteByte(value[0])
+		value = value[1:]
+	}
+	return builder.String()
+}
+
+// Determine if the AST is a string constant and return its value as a Go string.
+func (ast *AST) StringValue() (value string, ok bool) {
+	ast.context.do(func() {
+		ok = bool(C.Z3_is_string(ast.context.z3Context, ast.z3AST))
+		if ok {
+			value = unescapeString(C.GoString(C.Z3_get_string(ast.context.z3Context, ast.z3AST)))
+		}
+	}, ast)
+	return
+}
+
+// Create an empty sequence of the sequence sort.
+func (context *Context) NewEmptySequence(sort *Sort) *AST {
+	return compute(context, func() *AST {
+		return context.wrapAST(
+			C.Z3_mk_seq_empty(context.z3Context, sort.z3Sort),
+		)
+	}, sort)
+}
+
+// Create a unit sequence of the given element.
+func SeqUnit(element *AST) *AST {
+	return unary(
+		func(context C.Z3_context, element C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_unit(context, element)
+		}, element,
+	)
+}
+
+// Concatenate sequences.
+func SeqConcat(lhs *AST, rhs ...*AST) *AST {
+	return nary(
+		func(context C.Z3_context, length C.uint, operands ...C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_concat(context, length, &operands[0])
+		}, lhs, rhs...,
+	)
+}
+
+// Check if prefix is a prefix of sequence.
+func SeqPrefixOf(prefix, sequence *AST) *AST {
+	return binary(
+		func(context C.Z3_context, prefix, sequence C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_prefix(context, prefix, sequence)
+		}, prefix, sequence,
+	)
+}
+
+// Check if suffix is a suffix of sequence.
+func SeqSuffixOf(suffix, sequence *AST) *AST {
+	return binary(
+		func(context C.Z3_context, suffix, sequence C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_suffix(context, suffix, sequence)
+		}, suffix, sequence,
+	)
+}
+
+// Check if container contains containee.
+func SeqContains(container, containee *AST) *AST {
+	return binary(
+		func(context C.Z3_context, container, containee C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_contains(context, container, containee)
+		}, container, containee,
+	)
+}
+
+// Check if lhs is lexicographically strictly less than rhs.
+func StrLT(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_str_lt(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Check if lhs is equal or lexicographically strictly less than rhs.
+func StrLE(lhs, rhs *AST) *AST {
+	return binary(
+		func(context C.Z3_context, lhs, rhs C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_str_le(context, lhs, rhs)
+		}, lhs, rhs,
+	)
+}
+
+// Extract subsequence starting at offset of the given length.
+func SeqExtract(sequence, offset, length *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, sequence, offset, length C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_extract(context, sequence, offset, length)
+		}, sequence, offset, length,
+	)
+}
+
+// Replace the first occurrence of source with destination in sequence.
+func SeqReplace(sequence, source, destination *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, sequence, source, destination C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_replace(context, sequence, source, destination)
+		}, sequence, source, destination,
+	)
+}
+
+// Retrieve from sequence the unit sequence positioned at position index.
+// The sequence is empty if the index is out of bounds.
+func SeqAt(sequence, index *AST) *AST {
+	return binary(
+		func(context C.Z3_context, sequence, index C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_at(context, sequence, index)
+		}, sequence, index,
+	)
+}
+
+// Retrieve from sequence the element positioned at position index.
+// The function is under-specified if the index is out of bounds.
+func SeqNth(sequence, index *AST) *AST {
+	return binary(
+		func(context C.Z3_context, sequence, index C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_nth(context, sequence, index)
+		}, sequence, index,
+	)
+}
+
+// Return the length of the sequence.
+func SeqLength(sequence *AST) *AST {
+	return unary(
+		func(context C.Z3_context, sequence C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_length(context, sequence)
+		}, sequence,
+	)
+}
+
+// Return index of the first occurrence of substring in sequence starting from offset.
+//
+// If sequence does not contain substring, then the value is -1, if substring is the empty
+// string, then the value is offset. If offset is larger than the length of the sequence,
+// then the value is -1.
+func SeqIndexOf(sequence, substring, offset *AST) *AST {
+	return ternary(
+		func(context C.Z3_context, sequence, substring, offset C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_index(context, sequence, substring, offset)
+		}, sequence, substring, offset,
+	)
+}
+
+// Return index of the last occurrence of substring in sequence.
+// If sequence does not contain substring, then the value is -1.
+func SeqLastIndexOf(sequence, substring *AST) *AST {
+	return binary(
+		func(context C.Z3_context, sequence, substring C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_seq_last_index(context, sequence, substring)
+		}, sequence, substring,
+	)
+}
+
+// Convert string to integer.
+// The result is -1 if the string does not denote a non-negative integer.
+func StrToInt(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_str_to_int(context, operand)
+		}, operand,
+	)
+}
+
+// Integer to string conversion.
+// The result is the empty string if the integer is negative.
+func IntToStr(operand *AST) *AST {
+	return unary(
+		func(context C.Z3_context, operand C.Z3_ast) C.Z3_ast {
+			return C.Z3_mk_int_to_str(context, operand)
+		}, operand,
+	)
+}
@@ -0,0 +1,82 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringLiterals(t *testing.T) {
+	tests := []string{
+		"hello",
+		`back\slash`,
+		`\u{61}`,
+		"tab\tnew\nline",
+		"grüße",
+		"\"quoted\"",
+	}
+
+	for _, test := range tests {
+		// Arrange
+		config := NewConfig()
+		context := NewContext(config)
+
+		// Act
+		ast := context.NewString(test)
+		value, ok := ast.StringValue()
+
+		// Assert
+		assert.True(t, ok, test)
+		assert.Equal(t, test, value, test)
+		assert.True(t, ast.Sort().IsString(), test)
+	}
+}
+
+func TestStringOperations(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	hello := context.NewString("hello")
+	world := context.NewString("world")
+	s := context.NewConstant(WithName("s"), context.StringSort())
+	length := func(value int) *AST { return context.NewInt(value, integer) }
+
+	// Act
+	greeting := SeqConcat(hello, context.NewString(" "), world)
+
+	// Assert
+	assert.True(t, solver.Proven(Eq(SeqLength(greeting), length(11))))
+	assert.True(t, solver.Proven(Eq(SeqExtract(greeting, length(6), length(5)), world)))
+	assert.True(t, solver.Proven(Eq(SeqIndexOf(greeting, world, length(0)), length(6))))
+	assert.True(t, solver.Proven(Eq(SeqAt(hello, length(1)), context.NewString("e"))))
+	assert.True(t, solver.Proven(SeqContains(greeting, context.NewString("o w"))))
+	assert.True(t, solver.Proven(SeqPrefixOf(hello, greeting)))
+	assert.True(t, solver.Proven(SeqSuffixOf(world, greeting)))
+	assert.True(t, solver.Proven(StrLT(hello, world)))
+	assert.True(t, solver.Proven(Eq(StrToInt(context.NewString("42")), length(42))))
+	assert.True(t, solver.Proven(Eq(IntToStr(length(7)), context.NewString("7"))))
+	assert.True(t, solver.HasSolutionFor(And(
+		Eq(SeqReplace(s, hello, world), context.NewString("world!")),
+		Not(Eq(s, context.NewString("world!"))),
+	)))
+}
+
+func TestSequenceOfIntegers(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	sort := context.SequenceSort(integer)
+	one := context.NewInt(1, integer)
+
+	// Act
+	sequence := SeqConcat(context.NewEmptySequence(sort), SeqUnit(one))
+
+	// Assert
+	assert.Equal(t, KindSequence, sort.Kind())
+	assert.Equal(t, KindInt, sort.SequenceBasis().Kind())
+	assert.True(t, solver.Proven(Eq(SeqNth(sequence, context.NewInt(0, integer)), one)))
+}
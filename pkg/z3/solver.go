@@ -6,12 +6,18 @@ package z3
 #include "../../modules/z3/src/api/z3.h"
 */
 import "C"
-import "runtime"
+import (
+	gocontext "context"
+	"runtime"
+)
 
 // Incremental solver, possibly specialized by a particular tactic or logic.
 type Solver struct {
 	context  *Context
 	z3Sovler C.Z3_solver
+
+	// canceled is set if the last check was canceled by its Go context.
+	canceled bool
 }
 
 // Create a new solver. This solver is a "combined solver" that internally
@@ -36,20 +42,39 @@ type Solver struct {
 // Note however it is possible to set the solver2_timeout,
 // solver2_unknown, and ignore_solver1 parameters of the combined
 // solver to change its behaviour.
-func (context *Context) NewSolver() (solver *Solver) {
-	context.do(func() {
-		solver = &Solver{
-			context:  context,
-			z3Sovler: C.Z3_mk_solver(context.z3Context),
-		}
-
-		// User must use Z3_solver_inc_ref and Z3_solver_dec_ref to manage solver objects.
-		// Even if the context was created using Z3_mk_context instead of Z3_mk_context_rc.
-		C.Z3_solver_inc_ref(context.z3Context, solver.z3Sovler)
+func (context *Context) NewSolver() *Solver {
+	return compute(context, func() *Solver {
+		return context.wrapSolver(
+			C.Z3_mk_solver(context.z3Context),
+		)
 	})
+}
+
+// Create a new solver that is implemented using the given tactic.
+// The solver supports the commands Push and Pop, but it will always solve
+// each Check from scratch.
+func (context *Context) NewSolverFromTactic(tactic *Tactic) *Solver {
+	return compute(context, func() *Solver {
+		return context.wrapSolver(
+			C.Z3_mk_solver_from_tactic(context.z3Context, tactic.z3Tactic),
+		)
+	}, tactic)
+}
+
+func (context *Context) wrapSolver(z3Solver C.Z3_solver) *Solver {
+	context.check()
+
+	solver := &Solver{
+		context:  context,
+		z3Sovler: z3Solver,
+	}
+
+	// User must use Z3_solver_inc_ref and Z3_solver_dec_ref to manage solver objects.
+	// Even if the context was created using Z3_mk_context instead of Z3_mk_context_rc.
+	C.Z3_solver_inc_ref(context.z3Context, solver.z3Sovler)
 
 	runtime.SetFinalizer(solver, func(solver *Solver) {
-		context.do(func() {
+		context.release(func() {
 			C.Z3_solver_dec_ref(context.z3Context, solver.z3Sovler)
 		})
 	})
@@ -67,8 +92,23 @@ func (solver *Solver) Assert(ast *AST) {
 	}, solver, ast)
 }
 
+// Assert a constraint into the solver, and track it (in the unsat) core using the Boolean constant tracker.
+//
+// This API is an alternative to CheckAssumptions for extracting unsat cores.
+// Both APIs can be used in the same solver. The unsat core will contain a combination
+// of the Boolean variables provided using AssertAndTrack and the Boolean literals
+// provided using CheckAssumptions.
+func (solver *Solver) AssertAndTrack(ast, tracker *AST) {
+	solver.context.do(func() {
+		C.Z3_solver_assert_and_track(solver.context.z3Context, solver.z3Sovler, ast.z3AST, tracker.z3AST)
+	}, solver, ast, tracker)
+}
+
 func (solver *Solver) ReasonUnknown() (reason string) {
 	return compute(solver.context, func() string {
+		if solver.canceled {
+			return "canceled"
+		}
 		return C.GoString(
 			C.Z3_solver_get_reason_unknown(solver.context.z3Context, solver.z3Sovler),
 		)
@@ -77,12 +117,68 @@ func (solver *Solver) ReasonUnknown() (reason string) {
 
 func (solver *Solver) Check() LiftedBoolean {
 	return compute(solver.context, func() LiftedBoolean {
+		solver.canceled = false
 		return LiftedBoolean(
 			C.Z3_solver_check(solver.context.z3Context, solver.z3Sovler),
 		)
 	}, solver)
 }
 
+// Check whether the assertions in the given solver and optional assumptions are consistent or not.
+//
+// The assumptions are Boolean literals that only hold during this check. If the result is
+// false, the subset of the assumptions (and of the trackers of AssertAndTrack) that was used
+// in the proof of unsatisfiability is available through UnsatCore.
+func (solver *Solver) CheckAssumptions(assumptions ...*AST) LiftedBoolean {
+	z3Assumptions := make([]C.Z3_ast, len(assumptions))
+	for idx := range assumptions {
+		z3Assumptions[idx] = assumptions[idx].z3AST
+	}
+
+	return compute(solver.context, func() LiftedBoolean {
+		solver.canceled = false
+		return LiftedBoolean(
+			C.Z3_solver_check_assumptions(
+				solver.context.z3Context, solver.z3Sovler,
+				C.uint(len(z3Assumptions)), pointerTo(z3Assumptions),
+			),
+		)
+	}, solver, assumptions)
+}
+
+// Check whether the assertions in the given solver are consistent or not,
+// interrupting the check when the Go context is canceled or its deadline passes.
+//
+// If the check is interrupted, the result is undefined and ReasonUnknown returns "canceled".
+// As the interrupt applies to the whole Z3 context, operations performed concurrently
+// on the same context may be interrupted as well.
+func (solver *Solver) CheckContext(ctx gocontext.Context) LiftedBoolean {
+	// A skipped check yields the zero value, i.e., undefined.
+	// A check that completed with a definite result before being interrupted is still valid.
+	result, done := interruptible(solver.context, ctx, solver.Check)
+	if done && result.IsUndefined() {
+		solver.context.do(func() {
+			solver.canceled = true
+		}, solver)
+	}
+	return result
+}
+
+// Retrieve the unsat core for the last CheckAssumptions.
+// The unsat core is a subset of the assumptions and the trackers of AssertAndTrack.
+//
+// By default, the unsat core will not be minimized. Generation of a minimized
+// unsat core can be enabled via the "sat.core.minimize" and "smt.core.minimize"
+// settings for SAT and SMT cores respectively. Generation of minimized unsat cores
+// will be more expensive.
+func (solver *Solver) UnsatCore() []*AST {
+	return compute(solver.context, func() []*AST {
+		return solver.context.wrapASTs(
+			C.Z3_solver_get_unsat_core(solver.context.z3Context, solver.z3Sovler),
+		)
+	}, solver)
+}
+
 func (solver *Solver) Reset() {
 	solver.context.do(func() {
 		C.Z3_solver_reset(solver.context.z3Context, solver.z3Sovler)
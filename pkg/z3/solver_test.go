@@ -1,7 +1,9 @@
 package z3
 
 import (
+	gocontext "context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -63,3 +65,100 @@ func TestAsdDsa(t *testing.T) {
 	// Assert
 	assert.False(t, solver.HasSolution())
 }
+
+func TestUnsatCoreWithAssumptions(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	p := context.NewConstant(WithName("p"), context.BooleanSort())
+	q := context.NewConstant(WithName("q"), context.BooleanSort())
+	r := context.NewConstant(WithName("r"), context.BooleanSort())
+	solver.Assert(Implies(p, GT(x, context.NewInt(10, integer))))
+	solver.Assert(Implies(q, LT(x, context.NewInt(5, integer))))
+	solver.Assert(Implies(r, GT(x, context.NewInt(0, integer))))
+
+	// Act
+	sat := solver.CheckAssumptions(p, q, r)
+	core := solver.UnsatCore()
+
+	// Assert
+	assert.True(t, sat.IsFalse())
+	assumptions := make([]string, len(core))
+	for idx := range core {
+		assumptions[idx] = core[idx].String()
+	}
+	assert.ElementsMatch(t, []string{"p", "q"}, assumptions)
+	assert.True(t, solver.CheckAssumptions(p, r).IsTrue())
+}
+
+func TestUnsatCoreWithTrackedAssertions(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	minimum := context.NewConstant(WithName("minimum"), context.BooleanSort())
+	maximum := context.NewConstant(WithName("maximum"), context.BooleanSort())
+	positive := context.NewConstant(WithName("positive"), context.BooleanSort())
+
+	// Act
+	solver.AssertAndTrack(GE(x, context.NewInt(10, integer)), minimum)
+	solver.AssertAndTrack(GT(x, context.NewInt(0, integer)), positive)
+	solver.AssertAndTrack(LE(x, context.NewInt(5, integer)), maximum)
+	sat := solver.CheckAssumptions()
+	core := solver.UnsatCore()
+
+	// Assert
+	assert.True(t, sat.IsFalse())
+	tracked := make([]string, len(core))
+	for idx := range core {
+		tracked[idx] = core[idx].String()
+	}
+	assert.ElementsMatch(t, []string{"minimum", "maximum"}, tracked)
+}
+
+func TestCheckContextDeadline(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	z := context.NewConstant(WithName("z"), integer)
+	one := context.NewInt(1, integer)
+	solver.Assert(And(GT(x, one), GT(y, one), GT(z, one)))
+	solver.Assert(Eq(Add(Multiply(x, x, x), Multiply(y, y, y)), Multiply(z, z, z)))
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Act
+	result := solver.CheckContext(ctx)
+
+	// Assert
+	assert.True(t, result.IsUndefined())
+	assert.Equal(t, "canceled", solver.ReasonUnknown())
+}
+
+func TestCheckContextCanceled(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	solver := context.NewSolver()
+	solver.Assert(context.NewTrue())
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+
+	// Act
+	canceled := solver.CheckContext(ctx)
+	completed := solver.CheckContext(gocontext.Background())
+
+	// Assert
+	assert.True(t, canceled.IsUndefined())
+	assert.True(t, completed.IsTrue())
+	assert.NotEqual(t, "canceled", solver.ReasonUnknown())
+}
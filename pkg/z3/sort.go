@@ -6,6 +6,7 @@ package z3
 #include "../../modules/z3/src/api/z3.h"
 */
 import "C"
+import "runtime"
 
 // Kind of AST used to represent types.
 type Sort struct {
@@ -14,14 +15,16 @@ type Sort struct {
 }
 
 func (sort *Sort) AST() *AST {
-	return sort.context.wrapAST(
-		C.Z3_sort_to_ast(sort.context.z3Context, sort.z3Sort),
-	)
+	return compute(sort.context, func() *AST {
+		return sort.context.wrapAST(
+			C.Z3_sort_to_ast(sort.context.z3Context, sort.z3Sort),
+		)
+	}, sort)
 }
 
 func (sort *Sort) Zero() (zero *AST) {
 	switch sort.Kind() {
-	case KindInt:
+	case KindInt, KindBitVector:
 		zero = sort.context.NewInt(0, sort)
 	case KindBoolean:
 		zero = sort.context.NewFalse()
@@ -34,7 +37,9 @@ func (sort *Sort) Context() *Context {
 }
 
 func (sort *Sort) Kind() Kind {
-	return Kind(C.Z3_get_sort_kind(sort.context.z3Context, sort.z3Sort))
+	return compute(sort.context, func() Kind {
+		return Kind(C.Z3_get_sort_kind(sort.context.z3Context, sort.z3Sort))
+	}, sort)
 }
 
 func (sort *Sort) SameAs(others ...*Sort) bool {
@@ -47,26 +52,103 @@ func (sort *Sort) SameAs(others ...*Sort) bool {
 }
 
 func (context *Context) wrapSort(z3Sort C.Z3_sort) *Sort {
-	return &Sort{
+	context.check()
+
+	sort := &Sort{
 		context: context,
 		z3Sort:  z3Sort,
 	}
+
+	// Sorts are ASTs as well and must be kept alive while referenced from Go, e.g. datatype sorts own their constructors.
+	C.Z3_inc_ref(context.z3Context, C.Z3_sort_to_ast(context.z3Context, z3Sort))
+	runtime.SetFinalizer(sort, func(sort *Sort) {
+		context.release(func() {
+			C.Z3_dec_ref(context.z3Context, C.Z3_sort_to_ast(context.z3Context, sort.z3Sort))
+		}, sort)
+	})
+
+	return sort
 }
 
 func (context *Context) BooleanSort() *Sort {
-	return context.wrapSort(
-		C.Z3_mk_bool_sort(context.z3Context),
-	)
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_bool_sort(context.z3Context),
+		)
+	})
 }
 
 func (context *Context) IntegerSort() *Sort {
-	return context.wrapSort(
-		C.Z3_mk_int_sort(context.z3Context),
-	)
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_int_sort(context.z3Context),
+		)
+	})
 }
 
 func (context *Context) RealSort() *Sort {
-	return context.wrapSort(
-		C.Z3_mk_real_sort(context.z3Context),
-	)
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_real_sort(context.z3Context),
+		)
+	})
+}
+
+// Return the name of the sort, e.g., the name given to UninterpretedSort or "Int" for IntegerSort.
+func (sort *Sort) Name() Symbol {
+	return compute(sort.context, func() Symbol {
+		return Symbol{
+			context:  sort.context,
+			z3Symbol: C.Z3_get_sort_name(sort.context.z3Context, sort.z3Sort),
+		}
+	}, sort)
+}
+
+// Create a free (uninterpreted) type with the given name.
+//
+// Two uninterpreted sorts with the same name are the same sort. The elements of the sort are only
+// distinguished by the constraints on them, so they are suited for abstract identifiers.
+func (context *Context) UninterpretedSort(symbolFactory SymbolFactory) *Sort {
+	symbol := symbolFactory(context)
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_uninterpreted_sort(context.z3Context, symbol.z3Symbol),
+		)
+	})
+}
+
+// Create a finite domain sort with the given name and number of elements.
+//
+// The elements of the sort are the numerals 0 to size-1, see NewFiniteDomainValue.
+// Finite domain sorts are mainly used by the Datalog engine of Fixedpoint.
+func (context *Context) FiniteDomainSort(symbolFactory SymbolFactory, size uint64) *Sort {
+	symbol := symbolFactory(context)
+	return compute(context, func() *Sort {
+		return context.wrapSort(
+			C.Z3_mk_finite_domain_sort(context.z3Context, symbol.z3Symbol, C.uint64_t(size)),
+		)
+	})
+}
+
+// Return the number of elements of the finite domain sort.
+// The second result is false if the sort is not a finite domain sort.
+func (sort *Sort) FiniteDomainSize() (size uint64, ok bool) {
+	sort.context.do(func() {
+		if C.Z3_get_sort_kind(sort.context.z3Context, sort.z3Sort) != C.Z3_FINITE_DOMAIN_SORT {
+			return
+		}
+		var z3Size C.uint64_t
+		ok = bool(C.Z3_get_finite_domain_sort_size(sort.context.z3Context, sort.z3Sort, &z3Size))
+		size = uint64(z3Size)
+	}, sort)
+	return size, ok
+}
+
+// Create the element with the given index of the finite domain sort.
+// Panics with an *Error if the index is not smaller than the size of the sort.
+func (context *Context) NewFiniteDomainValue(index uint64, sort *Sort) *AST {
+	if size, ok := sort.FiniteDomainSize(); ok && index >= size {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "finite domain values must be smaller than the size of the sort"})
+	}
+	return context.NewUint64(index, sort)
 }
@@ -1,6 +1,7 @@
 package z3
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,3 +41,49 @@ func TestSameAs(t *testing.T) {
 		assert.Equal(t, test.same, same)
 	}
 }
+
+func TestUninterpretedSort(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	solver := context.NewSolver()
+
+	// Act
+	user := context.UninterpretedSort(WithName("User"))
+	alice := context.NewConstant(WithName("alice"), user)
+	bob := context.NewConstant(WithName("bob"), user)
+
+	// Assert
+	assert.Equal(t, KindUninterpreted, user.Kind())
+	assert.Equal(t, "User", user.Name().String())
+	assert.True(t, user.SameAs(context.UninterpretedSort(WithName("User"))))
+	assert.False(t, user.SameAs(context.UninterpretedSort(WithName("Resource"))))
+	assert.True(t, solver.HasSolutionFor(Eq(alice, bob)))
+	assert.True(t, solver.HasSolutionFor(Distinct(alice, bob)))
+	_, ok := user.FiniteDomainSize()
+	assert.False(t, ok)
+}
+
+func TestFiniteDomainSort(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+
+	// Act
+	resource := context.FiniteDomainSort(WithName("Resource"), 3)
+	size, ok := resource.FiniteDomainSize()
+	last := context.NewFiniteDomainValue(2, resource)
+	_, err := Try(func() *AST { return context.NewFiniteDomainValue(3, resource) })
+
+	// Assert
+	assert.Equal(t, KindFiniteDomain, resource.Kind())
+	assert.Equal(t, "Resource", resource.Name().String())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), size)
+	assert.True(t, last.IsNumeral())
+	assert.True(t, last.Sort().SameAs(resource))
+	value, ok := last.Uint64()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), value)
+	var z3Error *Error
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+}
@@ -0,0 +1,138 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+*/
+import "C"
+import (
+	"strconv"
+	"time"
+)
+
+// Value of a statistics entry. Z3 reports counters, e.g., the number of conflicts, as unsigned integers,
+// and measurements, e.g., the memory in megabytes or the time in seconds, as doubles.
+type StatisticsValue struct {
+	Uint     uint64  // The value of an unsigned integer entry.
+	Double   float64 // The value of a double entry.
+	IsDouble bool    // Set if the entry is a double.
+}
+
+// Return the value as a double, regardless of the type of the entry.
+func (value StatisticsValue) Float64() float64 {
+	if value.IsDouble {
+		return value.Double
+	}
+	return float64(value.Uint)
+}
+
+func (value StatisticsValue) String() string {
+	if value.IsDouble {
+		return strconv.FormatFloat(value.Double, 'f', -1, 64)
+	}
+	return strconv.FormatUint(value.Uint, 10)
+}
+
+// Statistics of a solver, mapping the keys of the entries reported by Z3 to their values.
+// The statistics are a snapshot, they do not change when the solver continues.
+type Statistics map[string]StatisticsValue
+
+// Convert the Z3 statistics into a Go map. Has to be called in a locked state.
+func (context *Context) wrapStatistics(z3Statistics C.Z3_stats) Statistics {
+	context.check()
+
+	// The statistics are copied, so the Z3 object is released immediately.
+	C.Z3_stats_inc_ref(context.z3Context, z3Statistics)
+	defer C.Z3_stats_dec_ref(context.z3Context, z3Statistics)
+
+	size := C.Z3_stats_size(context.z3Context, z3Statistics)
+	statistics := make(Statistics, size)
+	for idx := C.uint(0); idx < size; idx++ {
+		key := C.GoString(C.Z3_stats_get_key(context.z3Context, z3Statistics, idx))
+		if C.Z3_stats_is_uint(context.z3Context, z3Statistics, idx) {
+			statistics[key] = StatisticsValue{
+				Uint: uint64(C.Z3_stats_get_uint_value(context.z3Context, z3Statistics, idx)),
+			}
+		} else {
+			statistics[key] = StatisticsValue{
+				Double:   float64(C.Z3_stats_get_double_value(context.z3Context, z3Statistics, idx)),
+				IsDouble: true,
+			}
+		}
+	}
+	return statistics
+}
+
+// Return statistics about the last check of the solver, e.g., the number of conflicts and decisions,
+// the memory usage and the time spent.
+func (solver *Solver) Statistics() Statistics {
+	return compute(solver.context, func() Statistics {
+		return solver.context.wrapStatistics(
+			C.Z3_solver_get_statistics(solver.context.z3Context, solver.z3Sovler),
+		)
+	}, solver)
+}
+
+// Return statistics about the last check of the optimization context.
+func (optimize *Optimize) Statistics() Statistics {
+	return compute(optimize.context, func() Statistics {
+		return optimize.context.wrapStatistics(
+			C.Z3_optimize_get_statistics(optimize.context.z3Context, optimize.z3Optimize),
+		)
+	}, optimize)
+}
+
+// Return the number of conflicts, or 0 if the solver did not report any.
+func (statistics Statistics) Conflicts() uint64 {
+	return statistics.counter("conflicts", "sat conflicts")
+}
+
+// Return the number of decisions, or 0 if the solver did not report any.
+func (statistics Statistics) Decisions() uint64 {
+	return statistics.counter("decisions", "sat decisions")
+}
+
+// Return the number of propagations, or 0 if the solver did not report any.
+func (statistics Statistics) Propagations() uint64 {
+	return statistics.counter("propagations", "sat propagations 2ary", "sat propagations nary")
+}
+
+// Return the number of restarts, or 0 if the solver did not report any.
+func (statistics Statistics) Restarts() uint64 {
+	return statistics.counter("restarts", "sat restarts")
+}
+
+// Return the memory in megabytes used by Z3 when the statistics were collected.
+func (statistics Statistics) Memory() float64 {
+	return statistics["memory"].Float64()
+}
+
+// Return the maximal memory in megabytes used by Z3 when the statistics were collected.
+func (statistics Statistics) MaxMemory() float64 {
+	return statistics["max memory"].Float64()
+}
+
+// Return the time spent in the last check.
+func (statistics Statistics) Time() time.Duration {
+	return time.Duration(statistics["time"].Float64() * float64(time.Second))
+}
+
+// Sum the values of the given keys. The SMT core and the SAT core of Z3 report their counters using different keys.
+func (statistics Statistics) counter(keys ...string) uint64 {
+	var sum uint64
+	for _, key := range keys {
+		sum += uint64(statistics[key].Float64())
+	}
+	return sum
+}
+
+// Return the change of every entry from the previous snapshot to the statistics.
+// Entries missing from the previous snapshot are considered to be 0, entries only in the previous snapshot are ignored.
+func (statistics Statistics) Diff(previous Statistics) map[string]float64 {
+	diff := make(map[string]float64, len(statistics))
+	for key, value := range statistics {
+		diff[key] = value.Float64() - previous[key].Float64()
+	}
+	return diff
+}
@@ -0,0 +1,81 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolverStatistics(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	solver := context.NewSolver()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	solver.Assert(GT(Multiply(x, x), Add(y, context.NewInt(3, integer))))
+	solver.Assert(LT(Multiply(y, y), x))
+
+	// Act
+	solver.Check()
+	statistics := solver.Statistics()
+
+	// Assert
+	assert.NotEmpty(t, statistics)
+	assert.True(t, statistics["memory"].IsDouble)
+	assert.Greater(t, statistics.Memory(), 0.0)
+	assert.GreaterOrEqual(t, statistics.MaxMemory(), statistics.Memory())
+	assert.Greater(t, statistics.Decisions(), uint64(0))
+}
+
+func TestStatisticsValue(t *testing.T) {
+	// Arrange
+	counter := StatisticsValue{Uint: 42}
+	measurement := StatisticsValue{Double: 1.5, IsDouble: true}
+
+	// Act
+	values := []float64{counter.Float64(), measurement.Float64()}
+
+	// Assert
+	assert.Equal(t, []float64{42, 1.5}, values)
+	assert.Equal(t, "42", counter.String())
+	assert.Equal(t, "1.5", measurement.String())
+}
+
+func TestStatisticsDiff(t *testing.T) {
+	// Arrange
+	previous := Statistics{
+		"conflicts": {Uint: 10},
+		"memory":    {Double: 20, IsDouble: true},
+		"removed":   {Uint: 1},
+	}
+	current := Statistics{
+		"conflicts": {Uint: 25},
+		"memory":    {Double: 18.5, IsDouble: true},
+		"decisions": {Uint: 7},
+	}
+
+	// Act
+	diff := current.Diff(previous)
+
+	// Assert
+	assert.Equal(t, map[string]float64{"conflicts": 15, "memory": -1.5, "decisions": 7}, diff)
+	assert.Equal(t, uint64(25), current.Conflicts())
+}
+
+func TestOptimizeStatistics(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	optimize := context.NewOptimize()
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	optimize.Assert(LT(x, context.NewInt(10, integer)))
+	optimize.Maximize(x)
+
+	// Act
+	optimize.Check()
+	statistics := optimize.Statistics()
+
+	// Assert
+	assert.Greater(t, statistics.Memory(), 0.0)
+}
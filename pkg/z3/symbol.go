@@ -7,7 +7,10 @@ package z3
 #include <stdlib.h>
 */
 import "C"
-import "unsafe"
+import (
+	"strconv"
+	"unsafe"
+)
 
 type Symbol struct {
 	context  *Context
@@ -55,3 +58,14 @@ func (context *Context) NewIntegerSymbol(value int) Symbol {
 		}
 	})
 }
+
+// Return the name of the symbol. Integer symbols are converted to their decimal representation.
+func (symbol Symbol) String() string {
+	return compute(symbol.context, func() string {
+		z3Context := symbol.context.z3Context
+		if C.Z3_get_symbol_kind(z3Context, symbol.z3Symbol) == C.Z3_INT_SYMBOL {
+			return strconv.Itoa(int(C.Z3_get_symbol_int(z3Context, symbol.z3Symbol)))
+		}
+		return C.GoString(C.Z3_get_symbol_string(z3Context, symbol.z3Symbol))
+	})
+}
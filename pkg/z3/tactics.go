@@ -0,0 +1,360 @@
+package z3
+
+/*
+#cgo CFLAGS: -I../../modules/z3
+#cgo LDFLAGS: -L../../modules/z3 -lz3
+#include "../../modules/z3/src/api/z3.h"
+#include <stdlib.h>
+*/
+import "C"
+import (
+	gocontext "context"
+	"math"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+// Basic building block for creating custom solvers for specific problem domains.
+// A tactic transforms a goal into a set of subgoals.
+type Tactic struct {
+	context  *Context
+	z3Tactic C.Z3_tactic
+}
+
+func (context *Context) wrapTactic(z3Tactic C.Z3_tactic) *Tactic {
+	context.check()
+
+	tactic := &Tactic{
+		context:  context,
+		z3Tactic: z3Tactic,
+	}
+
+	C.Z3_tactic_inc_ref(context.z3Context, z3Tactic)
+	runtime.SetFinalizer(tactic, func(tactic *Tactic) {
+		context.release(func() {
+			C.Z3_tactic_dec_ref(context.z3Context, tactic.z3Tactic)
+		})
+	})
+
+	return tactic
+}
+
+// Return a tactic associated with the given name.
+// The complete list of tactics may be obtained using TacticNames.
+// Panics with an *Error if no tactic with the given name exists.
+func (context *Context) NewTactic(name string) *Tactic {
+	// Allocate an unmanged string and make sure it is freed.
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return compute(context, func() *Tactic {
+		return context.wrapTactic(
+			C.Z3_mk_tactic(context.z3Context, cName),
+		)
+	})
+}
+
+// Return the names of all tactics supported by Z3.
+func (context *Context) TacticNames() []string {
+	return compute(context, func() []string {
+		names := make([]string, C.Z3_get_num_tactics(context.z3Context))
+		for idx := range names {
+			names[idx] = C.GoString(C.Z3_get_tactic_name(context.z3Context, C.uint(idx)))
+		}
+		return names
+	})
+}
+
+// Return a string containing a description of the tactic with the given name.
+func (context *Context) TacticDescription(name string) string {
+	// Allocate an unmanged string and make sure it is freed.
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return compute(context, func() string {
+		return C.GoString(C.Z3_tactic_get_descr(context.z3Context, cName))
+	})
+}
+
+// Return a tactic that just returns the given goal.
+func (context *Context) SkipTactic() *Tactic {
+	return compute(context, func() *Tactic {
+		return context.wrapTactic(
+			C.Z3_tactic_skip(context.z3Context),
+		)
+	})
+}
+
+// Return a tactic that always fails.
+func (context *Context) FailTactic() *Tactic {
+	return compute(context, func() *Tactic {
+		return context.wrapTactic(
+			C.Z3_tactic_fail(context.z3Context),
+		)
+	})
+}
+
+// Return a tactic that fails if the goal is not trivially satisfiable (i.e., empty)
+// or trivially unsatisfiable (i.e., contains false).
+func (context *Context) FailIfNotDecidedTactic() *Tactic {
+	return compute(context, func() *Tactic {
+		return context.wrapTactic(
+			C.Z3_tactic_fail_if_not_decided(context.z3Context),
+		)
+	})
+}
+
+func (tactic *Tactic) Context() *Context {
+	return tactic.context
+}
+
+// Return a string containing a description of parameters accepted by the tactic.
+func (tactic *Tactic) Help() string {
+	return compute(tactic.context, func() string {
+		return C.GoString(C.Z3_tactic_get_help(tactic.context.z3Context, tactic.z3Tactic))
+	}, tactic)
+}
+
+// Return the parameter description set for the tactic.
+func (tactic *Tactic) ParamDescriptions() *ParamDescriptions {
+	return compute(tactic.context, func() *ParamDescriptions {
+		return tactic.context.wrapParamDescriptions(
+			C.Z3_tactic_get_param_descrs(tactic.context.z3Context, tactic.z3Tactic),
+		)
+	}, tactic)
+}
+
+// Apply the tactic to the goal.
+// Panics with an *Error if the tactic fails.
+func (tactic *Tactic) Apply(goal *Goal) *ApplyResult {
+	return compute(tactic.context, func() *ApplyResult {
+		return tactic.context.wrapApplyResult(
+			C.Z3_tactic_apply(tactic.context.z3Context, tactic.z3Tactic, goal.z3Goal),
+		)
+	}, tactic, goal)
+}
+
+// Apply the tactic to the goal using the given parameters.
+// Panics with an *Error if the tactic fails.
+func (tactic *Tactic) ApplyWithParams(goal *Goal, params *Params) *ApplyResult {
+	return compute(tactic.context, func() *ApplyResult {
+		return tactic.context.wrapApplyResult(
+			C.Z3_tactic_apply_ex(tactic.context.z3Context, tactic.z3Tactic, goal.z3Goal, params.z3Params),
+		)
+	}, tactic, goal, params)
+}
+
+// Apply the tactic to the goal, interrupting it when the Go context is canceled or its deadline passes.
+//
+// The error is the error of the Go context if the tactic was interrupted,
+// and an *Error if the tactic failed otherwise.
+func (tactic *Tactic) ApplyContext(ctx gocontext.Context, goal *Goal) (*ApplyResult, error) {
+	var err error
+	result, done := interruptible(tactic.context, ctx, func() *ApplyResult {
+		var result *ApplyResult
+		result, err = Try(func() *ApplyResult {
+			return tactic.Apply(goal)
+		})
+		return result
+	})
+	if done && result == nil {
+		return nil, ctx.Err()
+	}
+	return result, err
+}
+
+// Return a tactic that applies first to a given goal and the tactics of rest sequentially
+// to every subgoal produced by the preceding tactic.
+func AndThen(first *Tactic, rest ...*Tactic) *Tactic {
+	return combineTactics(
+		func(context C.Z3_context, lhs, rhs C.Z3_tactic) C.Z3_tactic {
+			return C.Z3_tactic_and_then(context, lhs, rhs)
+		}, first, rest...,
+	)
+}
+
+// Return a tactic that first applies first to a given goal, if it fails then
+// the tactics of rest are tried in order until one of them succeeds.
+func OrElse(first *Tactic, rest ...*Tactic) *Tactic {
+	return combineTactics(
+		func(context C.Z3_context, lhs, rhs C.Z3_tactic) C.Z3_tactic {
+			return C.Z3_tactic_or_else(context, lhs, rhs)
+		}, first, rest...,
+	)
+}
+
+// Combine the tactics from left to right using the given binary combinator.
+func combineTactics(
+	combinator func(context C.Z3_context, lhs, rhs C.Z3_tactic) C.Z3_tactic, first *Tactic, rest ...*Tactic,
+) *Tactic {
+	context := first.context
+	combined := first
+	for _, tactic := range rest {
+		combined = compute(context, func() *Tactic {
+			return context.wrapTactic(
+				combinator(context.z3Context, combined.z3Tactic, tactic.z3Tactic),
+			)
+		}, combined, tactic)
+	}
+	return combined
+}
+
+// Return a tactic that applies the given tactics in parallel to the goal.
+// The result is the one of the first tactic that succeeds.
+func ParOr(first *Tactic, rest ...*Tactic) *Tactic {
+	tactics := append([]*Tactic{first}, rest...)
+	z3Tactics := make([]C.Z3_tactic, len(tactics))
+	for idx, tactic := range tactics {
+		z3Tactics[idx] = tactic.z3Tactic
+	}
+
+	return compute(first.context, func() *Tactic {
+		return first.context.wrapTactic(
+			C.Z3_tactic_par_or(first.context.z3Context, C.uint(len(z3Tactics)), &z3Tactics[0]),
+		)
+	}, tactics)
+}
+
+// Return a tactic that applies first to a given goal and then second to every subgoal produced by first.
+// The subgoals are processed in parallel.
+func ParAndThen(first, second *Tactic) *Tactic {
+	return compute(first.context, func() *Tactic {
+		return first.context.wrapTactic(
+			C.Z3_tactic_par_and_then(first.context.z3Context, first.z3Tactic, second.z3Tactic),
+		)
+	}, first, second)
+}
+
+// Return a tactic that keeps applying the tactic until the goal is not modified anymore
+// or the maximum number of iterations is reached.
+func Repeat(tactic *Tactic, max uint) *Tactic {
+	return compute(tactic.context, func() *Tactic {
+		return tactic.context.wrapTactic(
+			C.Z3_tactic_repeat(tactic.context.z3Context, tactic.z3Tactic, C.uint(max)),
+		)
+	}, tactic)
+}
+
+// Return a tactic that applies the tactic to a given goal for the given timeout.
+// If the tactic does not terminate within the timeout, then it fails.
+// The timeout is truncated to milliseconds and limited to the maximum supported by Z3, which is about 49 days.
+// Panics with an *Error if the timeout is negative.
+func TryFor(tactic *Tactic, timeout time.Duration) *Tactic {
+	if timeout < 0 {
+		panic(&Error{Code: ErrorCodeInvalidArgument, Message: "tactic timeouts must not be negative"})
+	}
+	milliseconds := timeout.Milliseconds()
+	if milliseconds > math.MaxUint32 {
+		milliseconds = math.MaxUint32
+	}
+
+	return compute(tactic.context, func() *Tactic {
+		return tactic.context.wrapTactic(
+			C.Z3_tactic_try_for(tactic.context.z3Context, tactic.z3Tactic, C.uint(milliseconds)),
+		)
+	}, tactic)
+}
+
+// Return a tactic that applies the tactic to a given goal if the probe evaluates to true.
+// If the probe evaluates to false, then the new tactic behaves like the skip tactic.
+func When(probe *Probe, tactic *Tactic) *Tactic {
+	return compute(tactic.context, func() *Tactic {
+		return tactic.context.wrapTactic(
+			C.Z3_tactic_when(tactic.context.z3Context, probe.z3Probe, tactic.z3Tactic),
+		)
+	}, probe, tactic)
+}
+
+// Return a tactic that applies then to a given goal if the probe evaluates to true, and otherwise if not.
+func Cond(probe *Probe, then, otherwise *Tactic) *Tactic {
+	return compute(then.context, func() *Tactic {
+		return then.context.wrapTactic(
+			C.Z3_tactic_cond(then.context.z3Context, probe.z3Probe, then.z3Tactic, otherwise.z3Tactic),
+		)
+	}, probe, then, otherwise)
+}
+
+// Return a tactic that fails if the probe evaluates to false.
+func FailIf(probe *Probe) *Tactic {
+	return compute(probe.context, func() *Tactic {
+		return probe.context.wrapTactic(
+			C.Z3_tactic_fail_if(probe.context.z3Context, probe.z3Probe),
+		)
+	}, probe)
+}
+
+// Return a tactic that applies the tactic using the given set of parameters.
+func UsingParams(tactic *Tactic, params *Params) *Tactic {
+	return compute(tactic.context, func() *Tactic {
+		return tactic.context.wrapTactic(
+			C.Z3_tactic_using_params(tactic.context.z3Context, tactic.z3Tactic, params.z3Params),
+		)
+	}, tactic, params)
+}
+
+// Collection of subgoals resulting from applying of a tactic to a goal.
+type ApplyResult struct {
+	context       *Context
+	z3ApplyResult C.Z3_apply_result
+}
+
+func (context *Context) wrapApplyResult(z3ApplyResult C.Z3_apply_result) *ApplyResult {
+	context.check()
+
+	result := &ApplyResult{
+		context:       context,
+		z3ApplyResult: z3ApplyResult,
+	}
+
+	C.Z3_apply_result_inc_ref(context.z3Context, z3ApplyResult)
+	runtime.SetFinalizer(result, func(result *ApplyResult) {
+		context.release(func() {
+			C.Z3_apply_result_dec_ref(context.z3Context, result.z3ApplyResult)
+		})
+	})
+
+	return result
+}
+
+// Return the number of subgoals in the result.
+func (result *ApplyResult) NumSubgoals() uint {
+	return compute(result.context, func() uint {
+		return uint(C.Z3_apply_result_get_num_subgoals(result.context.z3Context, result.z3ApplyResult))
+	}, result)
+}
+
+// Return the subgoal at position idx of the result.
+func (result *ApplyResult) Subgoal(idx uint) *Goal {
+	return compute(result.context, func() *Goal {
+		return result.context.wrapGoal(
+			C.Z3_apply_result_get_subgoal(result.context.z3Context, result.z3ApplyResult, C.uint(idx)),
+		)
+	}, result)
+}
+
+// Return all subgoals of the result.
+func (result *ApplyResult) Subgoals() []*Goal {
+	return compute(result.context, func() []*Goal {
+		subgoals := make([]*Goal, C.Z3_apply_result_get_num_subgoals(result.context.z3Context, result.z3ApplyResult))
+		for idx := range subgoals {
+			subgoals[idx] = result.context.wrapGoal(
+				C.Z3_apply_result_get_subgoal(result.context.z3Context, result.z3ApplyResult, C.uint(idx)),
+			)
+		}
+		return subgoals
+	}, result)
+}
+
+// Convert a model of the subgoal at position idx into a model of the goal the tactic was applied to.
+func (result *ApplyResult) ConvertModel(idx uint, model *Model) *Model {
+	return result.Subgoal(idx).ConvertModel(model)
+}
+
+func (result *ApplyResult) String() string {
+	return compute(result.context, func() string {
+		return C.GoString(
+			C.Z3_apply_result_to_string(result.context.z3Context, result.z3ApplyResult),
+		)
+	}, result)
+}
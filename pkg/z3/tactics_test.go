@@ -0,0 +1,130 @@
+package z3
+
+import (
+	gocontext "context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTacticApply(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(Eq(x, Add(y, context.NewInt(1, integer))), GT(y, context.NewInt(2, integer)))
+	tactic := AndThen(context.NewTactic("simplify"), context.NewTactic("solve-eqs"))
+
+	// Act
+	result := tactic.Apply(goal)
+
+	// Assert
+	assert.Equal(t, uint(1), result.NumSubgoals())
+	subgoal := result.Subgoal(0)
+	assert.Equal(t, uint(1), subgoal.Size())
+	solver := context.NewSolver()
+	for _, formula := range subgoal.Formulas() {
+		solver.Assert(formula)
+	}
+	assert.True(t, solver.Check().IsTrue())
+	model := result.ConvertModel(0, solver.Model())
+	_, xValue := model.Eval(x, true)
+	_, yValue := model.Eval(y, true)
+	assert.True(t, context.NewSolver().Proven(Eq(xValue, Add(yValue, context.NewInt(1, integer)))))
+}
+
+func TestTacticCombinators(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(BVUGT(x, context.NewBitVector(3, 8)))
+	params := context.NewParams()
+	params.SetBool("elim_and", true)
+
+	// Act
+	skipped := OrElse(context.FailTactic(), context.SkipTactic()).Apply(goal)
+	repeated := Repeat(UsingParams(context.NewTactic("simplify"), params), 3).Apply(goal)
+	parallel := ParOr(context.FailTactic(), TryFor(context.NewTactic("smt"), time.Second)).Apply(goal)
+
+	// Assert
+	assert.Equal(t, goal.Formulas()[0].String(), skipped.Subgoal(0).Formulas()[0].String())
+	assert.Equal(t, uint(1), repeated.NumSubgoals())
+	assert.True(t, parallel.Subgoal(0).IsDecidedSat())
+}
+
+func TestTryForTimeouts(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(BVUGT(x, context.NewBitVector(3, 8)))
+
+	// Act
+	unlimited := TryFor(context.NewTactic("smt"), 100*24*time.Hour).Apply(goal)
+	_, err := Try(func() *Tactic { return TryFor(context.NewTactic("smt"), -time.Second) })
+
+	// Assert
+	var z3Error *Error
+	assert.True(t, unlimited.Subgoal(0).IsDecidedSat())
+	assert.True(t, errors.As(err, &z3Error))
+	assert.Equal(t, ErrorCodeInvalidArgument, z3Error.Code)
+}
+
+func TestSolverFromTactic(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	x := context.NewConstant(WithName("x"), context.BitVectorSort(8))
+	tactic := AndThen(context.NewTactic("simplify"), context.NewTactic("bit-blast"), context.NewTactic("sat"))
+	solver := context.NewSolverFromTactic(tactic)
+
+	// Act
+	solver.Assert(Eq(BVMultiply(x, context.NewBitVector(3, 8)), context.NewBitVector(9, 8)))
+	sat := solver.Check()
+
+	// Assert
+	assert.True(t, sat.IsTrue())
+	_, value := solver.Model().Eval(x, true)
+	assert.Equal(t, "#x03", value.String())
+}
+
+func TestTacticApplyContextCanceled(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+	goal := context.NewGoal(true, false, false)
+	goal.Assert(context.NewTrue())
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+
+	// Act
+	result, err := context.NewTactic("smt").ApplyContext(ctx, goal)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, gocontext.Canceled)
+}
+
+func TestTacticNames(t *testing.T) {
+	// Arrange
+	config := NewConfig()
+	context := NewContext(config)
+
+	// Act
+	names := context.TacticNames()
+
+	// Assert
+	assert.Contains(t, names, "simplify")
+	assert.NotEmpty(t, context.TacticDescription("simplify"))
+	assert.NotEmpty(t, context.NewTactic("simplify").Help())
+	assert.Contains(t, context.NewTactic("simplify").ParamDescriptions().Names(), "elim_and")
+	assert.Panics(t, func() { context.NewTactic("no-such-tactic") })
+}
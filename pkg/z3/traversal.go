@@ -0,0 +1,109 @@
+package z3
+
+// WalkOrder determines when a subterm is visited relative to its children.
+type WalkOrder int
+
+// The different orders of visiting subterms.
+const (
+	PreOrder  WalkOrder = iota // A subterm is visited before its children
+	PostOrder                  // A subterm is visited after its children
+)
+
+// Return the direct subterms of the AST, i.e., the arguments of an application or the body of a quantifier.
+func (ast *AST) children() []*AST {
+	switch ast.Kind() {
+	case ASTKindApp, ASTKindNumeral:
+		return ast.Args()
+	case ASTKindQuantifier:
+		return []*AST{ast.Body()}
+	}
+	return nil
+}
+
+// Visit every distinct subterm of the AST, including the AST itself, in the given order.
+//
+// Subterms are identified by their ID, so shared subterms are visited only once. This keeps the walk
+// linear in the size of the DAG, instead of exponential in the depth of the tree. The bodies of quantifiers
+// are visited as well, where bound variables appear as ASTs of the kind ASTKindVar.
+//
+// If visit returns false, the walk does not descend into the children of the subterm in pre-order, and
+// the walk stops in post-order.
+func Walk(ast *AST, order WalkOrder, visit func(node *AST) bool) {
+	visited := make(map[uint]struct{})
+
+	var walk func(node *AST) bool
+	walk = func(node *AST) bool {
+		id := node.ID()
+		if _, ok := visited[id]; ok {
+			return true
+		}
+		visited[id] = struct{}{}
+
+		if order == PreOrder && !visit(node) {
+			return true
+		}
+		for _, child := range node.children() {
+			if !walk(child) {
+				return false
+			}
+		}
+		if order == PostOrder {
+			return visit(node)
+		}
+		return true
+	}
+
+	walk(ast)
+}
+
+// Rebuild the AST bottom-up, applying rewrite to every distinct subterm after its children were rewritten.
+//
+// The rewrite receives the subterm with its arguments (or body) already replaced by their rewritten
+// versions and returns the replacement of the subterm, or the subterm itself to keep it. Shared subterms
+// are rewritten only once, the results are memoized by the ID of the original subterm.
+//
+// Note that the bodies of quantifiers refer to their bound variables by de Bruijn indices. Therefore,
+// rewrites that move subterms into or out of quantifiers have to take care of the indices.
+func Transform(ast *AST, rewrite func(node *AST) *AST) *AST {
+	rewritten := make(map[uint]*AST)
+
+	var transform func(node *AST) *AST
+	transform = func(node *AST) *AST {
+		id := node.ID()
+		if result, ok := rewritten[id]; ok {
+			return result
+		}
+
+		children := node.children()
+		changed := false
+		for idx, child := range children {
+			result := transform(child)
+			changed = changed || result.z3AST != child.z3AST
+			children[idx] = result
+		}
+
+		result := node
+		if changed {
+			result = node.Update(children)
+		}
+		result = rewrite(result)
+
+		rewritten[id] = result
+		return result
+	}
+
+	return transform(ast)
+}
+
+// Return the free constants of the AST, i.e., applications of uninterpreted functions without arguments,
+// in the order of their first occurrence.
+func FreeConstants(ast *AST) []*AST {
+	var constants []*AST
+	Walk(ast, PreOrder, func(node *AST) bool {
+		if node.IsApp() && node.NumArgs() == 0 && node.Decl().Kind() == DeclKindUninterpreted {
+			constants = append(constants, node)
+		}
+		return true
+	})
+	return constants
+}
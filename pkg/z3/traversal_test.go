@@ -0,0 +1,131 @@
+package z3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalkOrder(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	formula := LT(Add(x, y), y)
+
+	// Act
+	var pre, post []string
+	Walk(formula, PreOrder, func(node *AST) bool {
+		pre = append(pre, node.String())
+		return true
+	})
+	Walk(formula, PostOrder, func(node *AST) bool {
+		post = append(post, node.String())
+		return true
+	})
+
+	// Assert
+	assert.Equal(t, []string{"(< (+ x y) y)", "(+ x y)", "x", "y"}, pre)
+	assert.Equal(t, []string{"x", "y", "(+ x y)", "(< (+ x y) y)"}, post)
+}
+
+func TestWalkSharedSubterms(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	term := context.NewConstant(WithName("x"), integer)
+	for i := 0; i < 64; i++ {
+		term = Add(term, term)
+	}
+
+	// Act
+	visits := 0
+	Walk(term, PreOrder, func(node *AST) bool {
+		visits++
+		return true
+	})
+
+	// Assert
+	assert.Equal(t, 65, visits)
+}
+
+func TestWalkSkipChildren(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	formula := And(LT(x, y), GT(x, y))
+
+	// Act
+	var visited []string
+	Walk(formula, PreOrder, func(node *AST) bool {
+		visited = append(visited, node.String())
+		return node.Sort().Kind() == KindBoolean && node.Decl().Kind() == DeclKindAnd
+	})
+
+	// Assert
+	assert.Equal(t, []string{"(and (< x y) (> x y))", "(< x y)", "(> x y)"}, visited)
+}
+
+func TestTransform(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	formula := Or(LT(x, y), LT(y, x))
+
+	// Act
+	swapped := Transform(formula, func(node *AST) *AST {
+		if node.IsApp() && node.Decl().Kind() == DeclKindLT {
+			return GT(node.Arg(1), node.Arg(0))
+		}
+		return node
+	})
+
+	// Assert
+	assert.Equal(t, "(or (> y x) (> x y))", swapped.String())
+}
+
+func TestTransformQuantifier(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	c := context.NewConstant(WithName("c"), integer)
+	formula := ForAll([]*AST{x}, GE(Add(x, c), x))
+
+	// Act
+	replaced := Transform(formula, func(node *AST) *AST {
+		if node.Equals(c) {
+			return context.NewInt(1, integer)
+		}
+		return node
+	})
+
+	// Assert
+	assert.True(t, replaced.IsForAll())
+	assert.Equal(t, "(>= (+ (:var 0) 1) (:var 0))", replaced.Body().String())
+}
+
+func TestFreeConstants(t *testing.T) {
+	// Arrange
+	context := NewContext(NewConfig())
+	integer := context.IntegerSort()
+	x := context.NewConstant(WithName("x"), integer)
+	y := context.NewConstant(WithName("y"), integer)
+	z := context.NewConstant(WithName("z"), integer)
+	formula := And(LT(x, context.NewInt(2, integer)), Exists([]*AST{z}, Eq(Add(z, y), x)))
+
+	// Act
+	constants := FreeConstants(formula)
+
+	// Assert
+	names := make([]string, len(constants))
+	for idx := range constants {
+		names[idx] = constants[idx].String()
+	}
+	assert.Equal(t, []string{"x", "y"}, names)
+}